@@ -43,8 +43,12 @@ resource "kubernetes_ingress" "test" {
         path {
           path = "/*"
           backend {
-            service_name = kubernetes_service.test.metadata.0.name
-            service_port = 80
+            service {
+              name = kubernetes_service.test.metadata.0.name
+              port {
+                number = 80
+              }
+            }
           }
         }
       }
@@ -0,0 +1,155 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/client-go/discovery"
+)
+
+func dataSourceKubernetesAPIResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesAPIResourcesRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:        schema.TypeString,
+				Description: "Only return the versions and resources of this API group, e.g. `networking.k8s.io`. Use `core` for the core group.",
+				Optional:    true,
+			},
+			"api_versions": {
+				Type:        schema.TypeList,
+				Description: "API versions served by the API server, e.g. `v1` or `networking.k8s.io/v1`.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Description: "API groups served by the API server.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the group, empty for the core group.",
+							Computed:    true,
+						},
+						"versions": {
+							Type:        schema.TypeList,
+							Description: "Versions of the group served by the API server, e.g. `v1` or `v1beta1`.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"preferred_version": {
+							Type:        schema.TypeString,
+							Description: "Version of the group preferred by the API server.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Description: "Resources served by the API server, by API version. Subresources, e.g. `pods/status`, are not included.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:        schema.TypeString,
+							Description: "API version serving the resource, e.g. `networking.k8s.io/v1`.",
+							Computed:    true,
+						},
+						"group": {
+							Type:        schema.TypeString,
+							Description: "Group of the resource, empty for the core group.",
+							Computed:    true,
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Description: "Version of the resource, e.g. `v1`.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Plural name of the resource, e.g. `ingresses`.",
+							Computed:    true,
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Description: "Kind of the objects of the resource, e.g. `Ingress`.",
+							Computed:    true,
+						},
+						"namespaced": {
+							Type:        schema.TypeBool,
+							Description: "Whether the objects of the resource are namespaced.",
+							Computed:    true,
+						},
+						"verbs": {
+							Type:        schema.TypeList,
+							Description: "Verbs supported by the resource, e.g. `get`, `list` or `create`.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"short_names": {
+							Type:        schema.TypeList,
+							Description: "Short names of the resource, e.g. `ing`.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesAPIResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DiscoveryClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Refresh the discovery results, so that the resources added since they
+	// were cached, e.g. by a custom resource definition, are listed
+	dc.Invalidate()
+
+	var diags diag.Diagnostics
+	log.Printf("[INFO] Reading API groups and resources")
+	groups, lists, err := dc.ServerGroupsAndResources()
+	if err != nil {
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			return diag.Errorf("Failed to discover API resources: %s", err)
+		}
+		// The resources of the other groups are still returned when some
+		// groups fail, e.g. when an aggregated API is unavailable.
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Some API groups could not be discovered",
+			Detail:   err.Error(),
+		})
+	}
+
+	group := d.Get("group").(string)
+	apiVersions, flattenedGroups := flattenAPIGroups(groups, group)
+	resources := flattenAPIResources(lists, group)
+	log.Printf("[INFO] Received %d API versions and %d resources", len(apiVersions), len(resources))
+
+	err = d.Set("api_versions", apiVersions)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("groups", flattenedGroups)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("resources", resources)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(apiVersions, ",")))))
+	return diags
+}
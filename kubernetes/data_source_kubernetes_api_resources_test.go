@@ -0,0 +1,47 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceAPIResources_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceAPIResourcesConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.kubernetes_api_resources.all", "api_versions.*", "v1"),
+					resource.TestCheckTypeSetElemAttr("data.kubernetes_api_resources.all", "api_versions.*", "apps/v1"),
+					resource.TestCheckResourceAttr("data.kubernetes_api_resources.apps", "groups.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_api_resources.apps", "groups.0.name", "apps"),
+					resource.TestCheckResourceAttr("data.kubernetes_api_resources.apps", "groups.0.preferred_version", "v1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.kubernetes_api_resources.apps", "resources.*", map[string]string{
+						"api_version": "apps/v1",
+						"name":        "deployments",
+						"kind":        "Deployment",
+						"namespaced":  "true",
+					}),
+					resource.TestCheckResourceAttr("data.kubernetes_api_resources.core", "resources.0.api_version", "v1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceAPIResourcesConfig_basic() string {
+	return `
+data "kubernetes_api_resources" "all" {}
+
+data "kubernetes_api_resources" "apps" {
+  group = "apps"
+}
+
+data "kubernetes_api_resources" "core" {
+  group = "core"
+}
+`
+}
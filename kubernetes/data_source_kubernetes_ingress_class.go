@@ -0,0 +1,81 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	networking "k8s.io/api/networking/v1"
+)
+
+func dataSourceKubernetesIngressClass() *schema.Resource {
+	docIngressClass := networking.IngressClass{}.SwaggerDoc()
+	docIngressClassSpec := networking.IngressClassSpec{}.SwaggerDoc()
+	docParameters := networking.IngressClassParametersReference{}.SwaggerDoc()
+
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesIngressClassRead,
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("ingress class", false),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: docIngressClass["spec"],
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"controller": {
+							Type:        schema.TypeString,
+							Description: docIngressClassSpec["controller"],
+							Computed:    true,
+						},
+						"parameters": {
+							Type:        schema.TypeList,
+							Description: docIngressClassSpec["parameters"],
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"api_group": {
+										Type:        schema.TypeString,
+										Description: docParameters["apiGroup"],
+										Computed:    true,
+									},
+									"kind": {
+										Type:        schema.TypeString,
+										Description: docParameters["kind"],
+										Computed:    true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Description: docParameters["name"],
+										Computed:    true,
+									},
+									"scope": {
+										Type:        schema.TypeString,
+										Description: docParameters["scope"],
+										Computed:    true,
+									},
+									"namespace": {
+										Type:        schema.TypeString,
+										Description: docParameters["namespace"],
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Description: "Whether this ingress class is the default one, assigned to ingresses which don't specify an ingress class.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesIngressClassRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("metadata.0.name").(string)
+	d.SetId(name)
+	return resourceKubernetesIngressClassRead(ctx, d, meta)
+}
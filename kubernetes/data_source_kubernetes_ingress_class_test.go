@@ -0,0 +1,52 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceIngressClass_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesIngressClassDestroy,
+		Steps: []resource.TestStep{
+			{ // The first apply creates the resource. The second apply reads the resource using a data source.
+				Config: testAccKubernetesIngressClassConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kubernetes_ingress_class.test", "metadata.0.name", name),
+				),
+			},
+			{
+				Config: testAccKubernetesIngressClassConfig_modified(name) +
+					testAccKubernetesDataSourceIngressClassConfig_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_ingress_class.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("data.kubernetes_ingress_class.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_ingress_class.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_ingress_class.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("data.kubernetes_ingress_class.test", "spec.0.controller", "example.com/ingress-controller"),
+					resource.TestCheckResourceAttr("data.kubernetes_ingress_class.test", "spec.0.parameters.0.api_group", "k8s.example.com"),
+					resource.TestCheckResourceAttr("data.kubernetes_ingress_class.test", "spec.0.parameters.0.kind", "IngressParameters"),
+					resource.TestCheckResourceAttr("data.kubernetes_ingress_class.test", "spec.0.parameters.0.name", "external-lb"),
+					resource.TestCheckResourceAttr("data.kubernetes_ingress_class.test", "default", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceIngressClassConfig_read() string {
+	return `
+data "kubernetes_ingress_class" "test" {
+  metadata {
+    name = "${kubernetes_ingress_class.test.metadata.0.name}"
+  }
+}
+`
+}
@@ -23,16 +23,16 @@ func TestAccKubernetesDataSourceIngress_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("kubernetes_ingress.test", "metadata.0.resource_version"),
 					resource.TestCheckResourceAttrSet("kubernetes_ingress.test", "metadata.0.uid"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.#", "1"),
-					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.backend.#", "1"),
-					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.backend.0.service_name", "app1"),
-					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.backend.0.service_port", "443"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.default_backend.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.default_backend.0.service.0.name", "app1"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.default_backend.0.service.0.port.0.number", "443"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.#", "1"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.host", "server.domain.com"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.#", "1"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.path", "/.*"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.#", "1"),
-					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service_name", "app2"),
-					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service_port", "80"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service.0.name", "app2"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service.0.port.0.number", "80"),
 				),
 			},
 			{
@@ -44,16 +44,16 @@ func TestAccKubernetesDataSourceIngress_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.kubernetes_ingress.test", "metadata.0.resource_version"),
 					resource.TestCheckResourceAttrSet("data.kubernetes_ingress.test", "metadata.0.uid"),
 					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.#", "1"),
-					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.backend.#", "1"),
-					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.backend.0.service_name", "app1"),
-					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.backend.0.service_port", "443"),
+					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.default_backend.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.default_backend.0.service.0.name", "app1"),
+					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.default_backend.0.service.0.port.0.number", "443"),
 					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.rule.#", "1"),
 					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.rule.0.host", "server.domain.com"),
 					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.rule.0.http.#", "1"),
 					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.path", "/.*"),
 					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.#", "1"),
-					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service_name", "app2"),
-					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service_port", "80"),
+					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service.0.name", "app2"),
+					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service.0.port.0.number", "80"),
 				),
 			},
 		},
@@ -70,14 +70,20 @@ func TestAccKubernetesDataSourceIngress_regression(t *testing.T) {
 		CheckDestroy:      testAccCheckKubernetesIngressDestroy,
 		Steps: []resource.TestStep{
 			{ // Create resource and data source using schema v0.
-				Config: requiredProviders() + testAccKubernetesDataSourceIngressConfig_regression("kubernetes-released", name),
+				Config: requiredProviders() + testAccKubernetesDataSourceIngressConfig_regression("kubernetes-released", name, `service_name = kubernetes_service.test.metadata.0.name
+            service_port = 80`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "metadata.0.name", name),
 					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "metadata.0.name", name),
 				),
 			},
 			{ // Apply StateUpgrade to resource. This will cause data source to re-read the data.
-				Config: requiredProviders() + testAccKubernetesDataSourceIngressConfig_regression("kubernetes-local", name),
+				Config: requiredProviders() + testAccKubernetesDataSourceIngressConfig_regression("kubernetes-local", name, `service {
+              name = kubernetes_service.test.metadata.0.name
+              port {
+                number = 80
+              }
+            }`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("kubernetes_ingress.test", "status.0.load_balancer.0.ingress.0.hostname"),
 					resource.TestCheckNoResourceAttr("kubernetes_ingress.test", "load_balancer_ingress.0.hostname"),
@@ -95,17 +101,25 @@ func testAccKubernetesDataSourceIngressConfig_basic(name string) string {
     name = "%s"
   }
   spec {
-    backend {
-      service_name = "app1"
-      service_port = 443
+    default_backend {
+      service {
+        name = "app1"
+        port {
+          number = 443
+        }
+      }
     }
     rule {
       host = "server.domain.com"
       http {
         path {
           backend {
-            service_name = "app2"
-            service_port = 80
+            service {
+              name = "app2"
+              port {
+                number = 80
+              }
+            }
           }
           path = "/.*"
         }
@@ -128,7 +142,7 @@ func testAccKubernetesDataSourceIngressConfig_read() string {
 
 // Note: this test uses a unique namespace in order to avoid name collisions in AWS.
 // This ensures a unique TargetGroup for each test run.
-func testAccKubernetesDataSourceIngressConfig_regression(provider, name string) string {
+func testAccKubernetesDataSourceIngressConfig_regression(provider, name, backend string) string {
 	return fmt.Sprintf(`data "kubernetes_ingress" "test" {
   provider = %s
   metadata {
@@ -178,13 +192,12 @@ resource "kubernetes_ingress" "test" {
         path {
           path = "/*"
           backend {
-            service_name = kubernetes_service.test.metadata.0.name
-            service_port = 80
+            %s
           }
         }
       }
     }
   }
 }
-`, provider, provider, name, provider, name, provider, name)
+`, provider, provider, name, provider, name, provider, name, backend)
 }
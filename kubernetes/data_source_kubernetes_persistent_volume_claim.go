@@ -70,9 +70,68 @@ func dataSourceKubernetesPersistentVolumeClaim() *schema.Resource {
 							Optional:    true,
 							Computed:    true,
 						},
+						"volume_mode": {
+							Type:        schema.TypeString,
+							Description: "Defines what type of volume is required by the claim, Block or Filesystem.",
+							Computed:    true,
+						},
+						"data_source": {
+							Type:        schema.TypeList,
+							Description: "The source the volume was populated from, either a VolumeSnapshot object or another PVC.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"api_group": {
+										Type:        schema.TypeString,
+										Description: "The group for the resource being referenced.",
+										Computed:    true,
+									},
+									"kind": {
+										Type:        schema.TypeString,
+										Description: "The type of resource being referenced.",
+										Computed:    true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Description: "The name of resource being referenced.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"data_source_ref": {
+							Type:        schema.TypeList,
+							Description: "The object the volume was populated from.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"api_group": {
+										Type:        schema.TypeString,
+										Description: "The group for the resource being referenced.",
+										Computed:    true,
+									},
+									"kind": {
+										Type:        schema.TypeString,
+										Description: "The type of resource being referenced.",
+										Computed:    true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Description: "The name of resource being referenced.",
+										Computed:    true,
+									},
+									"namespace": {
+										Type:        schema.TypeString,
+										Description: "The namespace of resource being referenced.",
+										Computed:    true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
+			"status": persistentVolumeClaimStatusSchema(),
 		},
 	}
 }
@@ -0,0 +1,214 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// resourcesListPageSize is the number of objects requested from the API server per page.
+const resourcesListPageSize = 500
+
+func dataSourceKubernetesResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesResourcesRead,
+		Schema: map[string]*schema.Schema{
+			"api_version": {
+				Type:        schema.TypeString,
+				Description: "API version of the objects to list, e.g. `v1` or `apps/v1`.",
+				Required:    true,
+			},
+			"kind": {
+				Type:        schema.TypeString,
+				Description: "Kind of the objects to list, e.g. `Pod` or `Node`.",
+				Required:    true,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "Namespace to list the objects from. Objects of a namespaced kind are listed across all namespaces when this is not set. Must not be set for cluster-scoped kinds.",
+				Optional:    true,
+			},
+			"label_selector": {
+				Type:        schema.TypeString,
+				Description: "A selector to restrict the list of returned objects by their labels, e.g. `app=web,tier!=cache`.",
+				Optional:    true,
+			},
+			"field_selector": {
+				Type:        schema.TypeString,
+				Description: "A selector to restrict the list of returned objects by their fields, e.g. `status.phase=Running`.",
+				Optional:    true,
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of objects to return. All matching objects are returned when this is not set.",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Description: "List of the matching objects.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:        schema.TypeString,
+							Description: "API version of the object.",
+							Computed:    true,
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Description: "Kind of the object.",
+							Computed:    true,
+						},
+						"metadata": {
+							Type:        schema.TypeList,
+							Description: "Standard object's metadata.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "Name of the object.",
+										Computed:    true,
+									},
+									"namespace": {
+										Type:        schema.TypeString,
+										Description: "Namespace of the object, empty for cluster-scoped objects.",
+										Computed:    true,
+									},
+									"uid": {
+										Type:        schema.TypeString,
+										Description: "The unique in time and space value for this object.",
+										Computed:    true,
+									},
+									"resource_version": {
+										Type:        schema.TypeString,
+										Description: "An opaque value that represents the internal version of this object.",
+										Computed:    true,
+									},
+									"generation": {
+										Type:        schema.TypeInt,
+										Description: "A sequence number representing a specific generation of the desired state.",
+										Computed:    true,
+									},
+									"labels": {
+										Type:        schema.TypeMap,
+										Description: "Map of string keys and values attached to the object.",
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									"annotations": {
+										Type:        schema.TypeMap,
+										Description: "An unstructured key value map stored with the object.",
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"object": {
+							Type:        schema.TypeString,
+							Description: "The full object in JSON format. Only `metadata` is exposed as attributes, the other fields such as `spec` and `status` are read by decoding this with `jsondecode`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	gv, err := apimachineryschema.ParseGroupVersion(d.Get("api_version").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	gvk := gv.WithKind(d.Get("kind").(string))
+	namespace := d.Get("namespace").(string)
+
+	client, err := dataSourceKubernetesResourcesClient(meta, gvk, namespace)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	limit := int64(d.Get("limit").(int))
+	opts := metav1.ListOptions{
+		LabelSelector: d.Get("label_selector").(string),
+		FieldSelector: d.Get("field_selector").(string),
+	}
+
+	log.Printf("[INFO] Listing %s: %#v", gvk.Kind, opts)
+	objects := []interface{}{}
+	idsum := sha256.New()
+	for {
+		opts.Limit = resourcesListPageSize
+		if remaining := limit - int64(len(objects)); limit > 0 && remaining < opts.Limit {
+			opts.Limit = remaining
+		}
+		list, err := client.List(ctx, opts)
+		if err != nil {
+			log.Printf("[DEBUG] Received error: %#v", err)
+			return diag.Errorf("Failed to list %s: %s", gvk.Kind, err)
+		}
+		for i := range list.Items {
+			obj, err := flattenResourcesObject(&list.Items[i], meta.(KubeClientsets).IgnoreAnnotations(), meta.(KubeClientsets).IgnoreLabels())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			objects = append(objects, obj)
+			_, err = idsum.Write([]byte(list.Items[i].GetUID()))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		opts.Continue = list.GetContinue()
+		if opts.Continue == "" || (limit > 0 && int64(len(objects)) >= limit) {
+			break
+		}
+	}
+	log.Printf("[INFO] Received %d %s objects", len(objects), gvk.Kind)
+
+	err = d.Set("objects", objects)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%x", idsum.Sum(nil)))
+	return nil
+}
+
+// dataSourceKubernetesResourcesClient returns a dynamic client for the kind.
+// Unlike manifestResourceClient, an empty namespace is not defaulted,
+// so that namespaced kinds are listed across all namespaces.
+func dataSourceKubernetesResourcesClient(m interface{}, gvk apimachineryschema.GroupVersionKind, namespace string) (dynamic.ResourceInterface, error) {
+	dc, err := m.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	mapper, err := m.(KubeClientsets).RESTMapper()
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := manifestResourceMapping(mapper, gvk)
+	if err != nil {
+		return nil, err
+	}
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		if namespace != "" {
+			return nil, fmt.Errorf("%s is a cluster-scoped kind and cannot be listed in namespace %q", gvk.Kind, namespace)
+		}
+		return dc.Resource(mapping.Resource), nil
+	}
+	if namespace == "" {
+		return dc.Resource(mapping.Resource), nil
+	}
+	return dc.Resource(mapping.Resource).Namespace(namespace), nil
+}
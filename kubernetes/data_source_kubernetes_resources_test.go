@@ -0,0 +1,120 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceResources_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceResourcesConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_resources.test", "objects.#", "2"),
+					resource.TestCheckResourceAttr("data.kubernetes_resources.test", "objects.0.api_version", "v1"),
+					resource.TestCheckResourceAttr("data.kubernetes_resources.test", "objects.0.kind", "ConfigMap"),
+					resource.TestCheckResourceAttr("data.kubernetes_resources.test", "objects.0.metadata.0.namespace", name),
+					resource.TestCheckResourceAttr("data.kubernetes_resources.test", "objects.0.metadata.0.labels.app", name),
+					resource.TestMatchResourceAttr("data.kubernetes_resources.test", "objects.0.object", regexp.MustCompile(`"data":\{"key":"value"\}`)),
+					resource.TestCheckResourceAttr("data.kubernetes_resources.limited", "objects.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_resources.by_field", "objects.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_resources.by_field", "objects.0.metadata.0.name", name+"-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceResources_clusterScoped(t *testing.T) {
+	rxPosNum := regexp.MustCompile("^[1-9][0-9]*$")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "kubernetes_resources" "test" {
+  api_version = "v1"
+  kind        = "Node"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.kubernetes_resources.test", "objects.#", rxPosNum),
+					resource.TestCheckResourceAttr("data.kubernetes_resources.test", "objects.0.metadata.0.namespace", ""),
+				),
+			},
+			{
+				Config: `
+data "kubernetes_resources" "test" {
+  api_version = "v1"
+  kind        = "Node"
+  namespace   = "default"
+}
+`,
+				ExpectError: regexp.MustCompile("cluster-scoped kind"),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceResourcesConfig_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_namespace" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+}
+
+resource "kubernetes_config_map" "test" {
+  count = 2
+
+  metadata {
+    name      = "%[1]s-${count.index}"
+    namespace = kubernetes_namespace.test.metadata.0.name
+    labels = {
+      app = "%[1]s"
+    }
+  }
+  data = {
+    key = "value"
+  }
+}
+
+data "kubernetes_resources" "test" {
+  api_version    = "v1"
+  kind           = "ConfigMap"
+  namespace      = kubernetes_namespace.test.metadata.0.name
+  label_selector = "app=%[1]s"
+
+  depends_on = [kubernetes_config_map.test]
+}
+
+data "kubernetes_resources" "limited" {
+  api_version    = "v1"
+  kind           = "ConfigMap"
+  namespace      = kubernetes_namespace.test.metadata.0.name
+  label_selector = "app=%[1]s"
+  limit          = 1
+
+  depends_on = [kubernetes_config_map.test]
+}
+
+data "kubernetes_resources" "by_field" {
+  api_version    = "v1"
+  kind           = "ConfigMap"
+  label_selector = "app=%[1]s"
+  field_selector = "metadata.name=%[1]s-1"
+
+  depends_on = [kubernetes_config_map.test]
+}
+`, name)
+}
@@ -0,0 +1,76 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceKubernetesServerVersion() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesServerVersionRead,
+		Schema: map[string]*schema.Schema{
+			"major": {
+				Type:        schema.TypeString,
+				Description: "Major version of the API server, e.g. `1`.",
+				Computed:    true,
+			},
+			"minor": {
+				Type:        schema.TypeString,
+				Description: "Minor version of the API server, e.g. `21`. Some distributions add a suffix, e.g. `21+`.",
+				Computed:    true,
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Description: "Semantic version of the API server without its build metadata, e.g. `1.21.2`.",
+				Computed:    true,
+			},
+			"git_version": {
+				Type:        schema.TypeString,
+				Description: "Full version of the API server, e.g. `v1.21.2-eks-0389ca3`.",
+				Computed:    true,
+			},
+			"git_commit": {
+				Type:        schema.TypeString,
+				Description: "Git commit the API server was built from.",
+				Computed:    true,
+			},
+			"build_date": {
+				Type:        schema.TypeString,
+				Description: "Date the API server was built.",
+				Computed:    true,
+			},
+			"go_version": {
+				Type:        schema.TypeString,
+				Description: "Version of Go the API server was built with.",
+				Computed:    true,
+			},
+			"platform": {
+				Type:        schema.TypeString,
+				Description: "Platform of the API server, e.g. `linux/amd64`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesServerVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Reading server version")
+	v, err := meta.(KubeClientsets).ServerVersion()
+	if err != nil {
+		return diag.Errorf("Failed to read server version: %s", err)
+	}
+	log.Printf("[INFO] Received server version: %#v", v)
+
+	d.SetId(v.GitVersion)
+	attrs := flattenServerVersion(v)
+	for k, v := range attrs {
+		err = d.Set(k, v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package kubernetes
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceServerVersion_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceServerVersionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_server_version.test", "major", "1"),
+					resource.TestMatchResourceAttr("data.kubernetes_server_version.test", "minor", regexp.MustCompile(`^[0-9]+\+?$`)),
+					resource.TestMatchResourceAttr("data.kubernetes_server_version.test", "version", regexp.MustCompile(`^1\.[0-9]+\.[0-9]+$`)),
+					resource.TestMatchResourceAttr("data.kubernetes_server_version.test", "git_version", regexp.MustCompile(`^v1\.`)),
+					resource.TestCheckResourceAttrSet("data.kubernetes_server_version.test", "platform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceServerVersionConfig_basic() string {
+	return `
+data "kubernetes_server_version" "test" {}
+`
+}
@@ -1,6 +1,8 @@
 package kubernetes
 
 import (
+	"reflect"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
@@ -16,3 +18,27 @@ func suppressEquivalentResourceQuantity(k, old, new string, d *schema.ResourceDa
 	}
 	return oldQ.Cmp(newQ) == 0
 }
+
+func suppressEquivalentManifest(k, old, new string, d *schema.ResourceData) bool {
+	oldObj, err := expandManifest(old)
+	if err != nil {
+		return false
+	}
+	newObj, err := expandManifest(new)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldObj.Object, newObj.Object)
+}
+
+func suppressEquivalentOpenAPIV3Schema(k, old, new string, d *schema.ResourceData) bool {
+	oldSchema, err := expandOpenAPIV3Schema(old)
+	if err != nil {
+		return false
+	}
+	newSchema, err := expandOpenAPIV3Schema(new)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldSchema, newSchema)
+}
@@ -0,0 +1,197 @@
+package kubernetes
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+)
+
+// oidcRefreshBefore is how long before its expiry an ID token is read again,
+// so that requests aren't sent with a token expiring in flight.
+const oidcRefreshBefore = 30 * time.Second
+
+// oidcTokenSource reads the OIDC ID token issued to the runner, e.g. by a CI
+// platform, from a file or an environment variable. The token is read again
+// when it's about to expire or was rejected, since the platform rotates it.
+// Its signature is verified by the API server, so only its claims are checked.
+type oidcTokenSource struct {
+	IssuerURL string
+	ClientID  string
+	TokenFile string
+	TokenEnv  string
+
+	now func() time.Time
+
+	lock    sync.Mutex
+	token   string
+	expires time.Time
+}
+
+func expandOIDCTokenSource(l []interface{}) (*oidcTokenSource, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	in := l[0].(map[string]interface{})
+	s := &oidcTokenSource{
+		IssuerURL: in["issuer_url"].(string),
+		ClientID:  in["client_id"].(string),
+		TokenFile: in["token_file"].(string),
+		TokenEnv:  in["token_env"].(string),
+		now:       time.Now,
+	}
+	if (s.TokenFile == "") == (s.TokenEnv == "") {
+		return nil, fmt.Errorf("Exactly one of token_file or token_env must be set")
+	}
+	return s, nil
+}
+
+type oidcClaims struct {
+	Issuer   string          `json:"iss"`
+	Audience json.RawMessage `json:"aud"`
+	Expiry   int64           `json:"exp"`
+}
+
+// Token returns the cached ID token, or reads it again when it's missing or
+// about to expire.
+func (s *oidcTokenSource) Token() (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.token != "" && s.now().Before(s.expires.Add(-oidcRefreshBefore)) {
+		return s.token, nil
+	}
+
+	token, err := s.read()
+	if err != nil {
+		return "", err
+	}
+	claims, err := parseOIDCClaims(token)
+	if err != nil {
+		return "", fmt.Errorf("Invalid OIDC ID token in %s: %s", s.location(), err)
+	}
+	if strings.TrimSuffix(claims.Issuer, "/") != strings.TrimSuffix(s.IssuerURL, "/") {
+		return "", fmt.Errorf("The OIDC ID token in %s was issued by %q, expected %q", s.location(), claims.Issuer, s.IssuerURL)
+	}
+	if !claims.hasAudience(s.ClientID) {
+		return "", fmt.Errorf("The OIDC ID token in %s isn't issued to the client %q", s.location(), s.ClientID)
+	}
+	if claims.Expiry == 0 {
+		// Without an expiry, the token is read again on each request
+		return token, nil
+	}
+	expires := time.Unix(claims.Expiry, 0)
+	if !s.now().Before(expires) {
+		return "", fmt.Errorf("The OIDC ID token in %s expired at %s", s.location(), expires.UTC().Format(time.RFC3339))
+	}
+	if token != s.token {
+		log.Printf("[DEBUG] Read OIDC ID token from %s, expiring at %s", s.location(), expires.UTC().Format(time.RFC3339))
+	}
+	s.token, s.expires = token, expires
+	return token, nil
+}
+
+// invalidate drops the cached token when it was rejected, unless it was
+// read again in the meantime.
+func (s *oidcTokenSource) invalidate(token string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.token == token {
+		s.token = ""
+	}
+}
+
+func (s *oidcTokenSource) read() (string, error) {
+	if s.TokenEnv != "" {
+		token := strings.TrimSpace(os.Getenv(s.TokenEnv))
+		if token == "" {
+			return "", fmt.Errorf("The OIDC ID token environment variable %s is not set", s.TokenEnv)
+		}
+		return token, nil
+	}
+	b, err := ioutil.ReadFile(s.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("Failed to read the OIDC ID token: %s", err)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("The OIDC ID token file %s is empty", s.TokenFile)
+	}
+	return token, nil
+}
+
+func (s *oidcTokenSource) location() string {
+	if s.TokenEnv != "" {
+		return fmt.Sprintf("environment variable %s", s.TokenEnv)
+	}
+	return s.TokenFile
+}
+
+func parseOIDCClaims(token string) (*oidcClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("expected a JWT of 3 parts, got %d", len(parts))
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the claims: %s", err)
+	}
+	claims := &oidcClaims{}
+	if err := json.Unmarshal(payload, claims); err != nil {
+		return nil, fmt.Errorf("failed to decode the claims: %s", err)
+	}
+	return claims, nil
+}
+
+// hasAudience reports whether the token is issued to the client.
+// The aud claim is either a single string or a list of strings.
+func (c *oidcClaims) hasAudience(clientID string) bool {
+	var aud []string
+	if err := json.Unmarshal(c.Audience, &aud); err != nil {
+		var single string
+		if err := json.Unmarshal(c.Audience, &single); err != nil {
+			return false
+		}
+		aud = []string{single}
+	}
+	for _, a := range aud {
+		if a == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// oidcRoundTripper sets the ID token as the bearer token of the requests.
+type oidcRoundTripper struct {
+	source *oidcTokenSource
+	rt     http.RoundTripper
+}
+
+func newOIDCRoundTripper(source *oidcTokenSource, rt http.RoundTripper) http.RoundTripper {
+	return &oidcRoundTripper{
+		source: source,
+		rt:     rt,
+	}
+}
+
+func (t *oidcRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.Token()
+	if err != nil {
+		return nil, err
+	}
+	req = utilnet.CloneRequest(req)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := t.rt.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		t.source.invalidate(token)
+	}
+	return resp, err
+}
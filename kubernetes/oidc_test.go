@@ -0,0 +1,134 @@
+package kubernetes
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testOIDCToken(t *testing.T, claims map[string]interface{}) string {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"RS256"}`)) + "." + enc.EncodeToString(payload) + "." + enc.EncodeToString([]byte("signature"))
+}
+
+func TestOIDCRoundTripper_rotation(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	path := filepath.Join(t.TempDir(), "token")
+	// The stand-in for the CI platform rotates the token file
+	rotate := func(subject string, expires time.Time) string {
+		token := testOIDCToken(t, map[string]interface{}{
+			"iss": "https://token.actions.example.com",
+			"aud": []string{"kubernetes"},
+			"sub": subject,
+			"exp": expires.Unix(),
+		})
+		if err := ioutil.WriteFile(path, []byte(token+"\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get("Authorization"))
+	}))
+	defer srv.Close()
+
+	source, err := expandOIDCTokenSource([]interface{}{map[string]interface{}{
+		"issuer_url": "https://token.actions.example.com/",
+		"client_id":  "kubernetes",
+		"token_file": path,
+		"token_env":  "",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	source.now = func() time.Time { return now }
+	client := &http.Client{Transport: newOIDCRoundTripper(source, http.DefaultTransport)}
+	get := func() {
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	first := rotate("first", now.Add(5*time.Minute))
+	get()
+	second := rotate("second", now.Add(10*time.Minute))
+	// The first token is still valid, so it's still used
+	get()
+	now = now.Add(4*time.Minute + 45*time.Second)
+	// The first token is about to expire, so the file is read again
+	get()
+
+	expected := []string{"Bearer " + first, "Bearer " + first, "Bearer " + second}
+	for i := range expected {
+		if received[i] != expected[i] {
+			t.Fatalf("Request %d: expected %q, got %q", i, expected[i], received[i])
+		}
+	}
+}
+
+func TestOIDCTokenSource_errors(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	cases := map[string]struct {
+		claims   map[string]interface{}
+		expected string
+	}{
+		"issuer": {
+			map[string]interface{}{"iss": "https://other.example.com", "aud": "kubernetes", "exp": now.Add(time.Hour).Unix()},
+			`issued by "https://other.example.com"`,
+		},
+		"audience": {
+			map[string]interface{}{"iss": "https://token.actions.example.com", "aud": "other", "exp": now.Add(time.Hour).Unix()},
+			`isn't issued to the client "kubernetes"`,
+		},
+		"expired": {
+			map[string]interface{}{"iss": "https://token.actions.example.com", "aud": "kubernetes", "exp": now.Add(-time.Minute).Unix()},
+			"expired at",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			os.Setenv("TF_TEST_OIDC_TOKEN", testOIDCToken(t, tc.claims))
+			defer os.Unsetenv("TF_TEST_OIDC_TOKEN")
+
+			source, err := expandOIDCTokenSource([]interface{}{map[string]interface{}{
+				"issuer_url": "https://token.actions.example.com",
+				"client_id":  "kubernetes",
+				"token_file": "",
+				"token_env":  "TF_TEST_OIDC_TOKEN",
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			source.now = func() time.Time { return now }
+			_, err = source.Token()
+			if err == nil || !strings.Contains(err.Error(), tc.expected) {
+				t.Fatalf("Expected an error containing %q, got %v", tc.expected, err)
+			}
+		})
+	}
+
+	_, err := expandOIDCTokenSource([]interface{}{map[string]interface{}{
+		"issuer_url": "https://token.actions.example.com",
+		"client_id":  "kubernetes",
+		"token_file": "/var/run/token",
+		"token_env":  "TF_TEST_OIDC_TOKEN",
+	}})
+	if err == nil {
+		t.Fatal("Expected an error when both token_file and token_env are set")
+	}
+}
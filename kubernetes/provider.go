@@ -8,17 +8,24 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/go-homedir"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -70,6 +77,19 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("KUBE_CLUSTER_CA_CERT_DATA", ""),
 				Description: "PEM-encoded root certificates bundle for TLS authentication.",
 			},
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KUBE_PROXY_URL", ""),
+				Description:  "URL of the proxy to use for requests to the Kubernetes API, with the http, https or socks5 scheme.",
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_TLS_SERVER_NAME", ""),
+				Description: "Server name to use for SNI and to verify the server certificate, instead of the hostname of the Kubernetes API.",
+			},
 			"config_paths": {
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -115,6 +135,10 @@ func Provider() *schema.Provider {
 						"api_version": {
 							Type:     schema.TypeString,
 							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"client.authentication.k8s.io/v1",
+								"client.authentication.k8s.io/v1beta1",
+							}, false),
 						},
 						"command": {
 							Type:     schema.TypeString,
@@ -130,16 +154,154 @@ func Provider() *schema.Provider {
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"interactive_mode": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     string(clientcmdapi.IfAvailableExecInteractiveMode),
+							Description: "Whether the plugin may prompt for input: `Never`, `IfAvailable` or `Always`.",
+							ValidateFunc: validation.StringInSlice([]string{
+								string(clientcmdapi.NeverExecInteractiveMode),
+								string(clientcmdapi.IfAvailableExecInteractiveMode),
+								string(clientcmdapi.AlwaysExecInteractiveMode),
+							}, false),
+						},
+						"provide_cluster_info": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Pass the address, TLS settings and proxy of the cluster to the plugin in the KUBERNETES_EXEC_INFO environment variable.",
+						},
 					},
 				},
 				Description: "",
 			},
+			"oidc": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Authenticate with an OIDC ID token issued to the runner, e.g. by a CI platform, which is read again before it expires.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issuer_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "URL of the OIDC issuer, which must match the iss claim of the token.",
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"client_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Client ID the token must be issued to, in its aud claim.",
+						},
+						"token_file": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Path to the file holding the ID token.",
+						},
+						"token_env": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the environment variable holding the ID token.",
+						},
+					},
+				},
+			},
+			"apply_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      applyModeClientSide,
+				Description:  "How resources send their changes to the API server. `server_side` creates and updates objects with server-side apply patches, so only the fields set by Terraform are owned by it.",
+				ValidateFunc: validation.StringInSlice([]string{applyModeClientSide, applyModeServerSide}, false),
+			},
+			"field_manager": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultFieldManager,
+				Description: "The name of the field manager used for server-side apply.",
+			},
+			"force_conflicts": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Take ownership of fields managed by another field manager when using server-side apply.",
+			},
+			"qps": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Description:  "Maximum number of requests per second sent to the Kubernetes API. Defaults to 5.",
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum burst of requests sent to the Kubernetes API on top of `qps`. Defaults to 10.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Retry the requests which are throttled or fail with a server error.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							Description:  "Maximum number of attempts for a request, including the first one.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "1s",
+							Description:  "Delay before the first retry, doubled on every following retry. The Retry-After header of the response takes precedence.",
+							ValidateFunc: validateDuration,
+						},
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "30s",
+							Description:  "Maximum delay between two retries.",
+							ValidateFunc: validateDuration,
+						},
+						"status_codes": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "HTTP status codes of the responses to retry. Defaults to 429, 500, 502, 503 and 504.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(400, 599),
+							},
+						},
+					},
+				},
+			},
+			"ignore_annotations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of regular expressions matching the keys of annotations to ignore on all resources and data sources, e.g. when they are managed by external systems. Annotations set in the configuration are never ignored.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsValidRegExp,
+				},
+			},
+			"ignore_labels": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of regular expressions matching the keys of labels to ignore on all resources and data sources, e.g. when they are managed by external systems. Labels set in the configuration are never ignored.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsValidRegExp,
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"kubernetes_all_namespaces":          dataSourceKubernetesAllNamespaces(),
 			"kubernetes_config_map":              dataSourceKubernetesConfigMap(),
 			"kubernetes_ingress":                 dataSourceKubernetesIngress(),
+			"kubernetes_ingress_class":           dataSourceKubernetesIngressClass(),
 			"kubernetes_namespace":               dataSourceKubernetesNamespace(),
 			"kubernetes_secret":                  dataSourceKubernetesSecret(),
 			"kubernetes_service":                 dataSourceKubernetesService(),
@@ -147,15 +309,20 @@ func Provider() *schema.Provider {
 			"kubernetes_storage_class":           dataSourceKubernetesStorageClass(),
 			"kubernetes_pod":                     dataSourceKubernetesPod(),
 			"kubernetes_persistent_volume_claim": dataSourceKubernetesPersistentVolumeClaim(),
+			"kubernetes_resources":               dataSourceKubernetesResources(),
+			"kubernetes_server_version":          dataSourceKubernetesServerVersion(),
+			"kubernetes_api_resources":           dataSourceKubernetesAPIResources(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"kubernetes_api_service":                      resourceKubernetesAPIService(),
 			"kubernetes_certificate_signing_request":      resourceKubernetesCertificateSigningRequest(),
+			"kubernetes_certificate_signing_request_v1":   resourceKubernetesCertificateSigningRequestV1(),
 			"kubernetes_cluster_role":                     resourceKubernetesClusterRole(),
 			"kubernetes_cluster_role_binding":             resourceKubernetesClusterRoleBinding(),
 			"kubernetes_config_map":                       resourceKubernetesConfigMap(),
 			"kubernetes_cron_job":                         resourceKubernetesCronJob(),
+			"kubernetes_custom_resource_definition":       resourceKubernetesCustomResourceDefinition(),
 			"kubernetes_csi_driver":                       resourceKubernetesCSIDriver(),
 			"kubernetes_daemonset":                        resourceKubernetesDaemonSet(),
 			"kubernetes_default_service_account":          resourceKubernetesDefaultServiceAccount(),
@@ -163,8 +330,10 @@ func Provider() *schema.Provider {
 			"kubernetes_endpoints":                        resourceKubernetesEndpoints(),
 			"kubernetes_horizontal_pod_autoscaler":        resourceKubernetesHorizontalPodAutoscaler(),
 			"kubernetes_ingress":                          resourceKubernetesIngress(),
+			"kubernetes_ingress_class":                    resourceKubernetesIngressClass(),
 			"kubernetes_job":                              resourceKubernetesJob(),
 			"kubernetes_limit_range":                      resourceKubernetesLimitRange(),
+			"kubernetes_manifest":                         resourceKubernetesManifest(),
 			"kubernetes_namespace":                        resourceKubernetesNamespace(),
 			"kubernetes_network_policy":                   resourceKubernetesNetworkPolicy(),
 			"kubernetes_persistent_volume":                resourceKubernetesPersistentVolume(),
@@ -187,6 +356,22 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	for name, gk := range resourceGroupKinds {
+		addWaitToResource(p.ResourcesMap[name], metadataWaitTarget(gk))
+	}
+	addWaitToResource(p.ResourcesMap["kubernetes_manifest"], manifestWaitTarget)
+	for _, r := range p.ResourcesMap {
+		r.ReadContext = deferReadUntilConfigured(r.ReadContext)
+	}
+
+	p.Schema["cluster"] = clusterSchema(p.Schema)
+	for _, r := range p.ResourcesMap {
+		addClusterToResource(r, true)
+	}
+	for _, r := range p.DataSourcesMap {
+		addClusterToResource(r, false)
+	}
+
 	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		return providerConfigure(ctx, d, p.TerraformVersion)
 	}
@@ -197,17 +382,53 @@ func Provider() *schema.Provider {
 type KubeClientsets interface {
 	MainClientset() (*kubernetes.Clientset, error)
 	AggregatorClientset() (*aggregator.Clientset, error)
+	ApiextensionsClientset() (*apiextensionsclientset.Clientset, error)
+	DynamicClient() (dynamic.Interface, error)
+	RESTMapper() (*restmapper.DeferredDiscoveryRESTMapper, error)
+	DiscoveryClient() (discovery.CachedDiscoveryInterface, error)
+	ServerVersion() (*version.Info, error)
+	ServedVersion(group, kind string, versions ...string) (string, error)
+	ServerSideApply() bool
+	IgnoreAnnotations() []*regexp.Regexp
+	IgnoreLabels() []*regexp.Regexp
 }
 
 type kubeClientsets struct {
-	config              *restclient.Config
+	config *restclient.Config
+
+	// lock guards the clients, which are created on first use
+	lock                sync.Mutex
 	mainClientset       *kubernetes.Clientset
 	aggregatorClientset *aggregator.Clientset
+	apiextensionsClient *apiextensionsclientset.Clientset
+	dynamicClient       dynamic.Interface
+	discoveryClient     discovery.CachedDiscoveryInterface
+	restMapper          *restmapper.DeferredDiscoveryRESTMapper
+
+	// discoveryLock guards the results of discovery
+	discoveryLock  sync.Mutex
+	serverVersion  *version.Info
+	servedVersions map[string]string
+
+	serverSideApply   bool
+	configErr         error
+	ignoreAnnotations []*regexp.Regexp
+	ignoreLabels      []*regexp.Regexp
+
+	// clusters holds the clientsets of the `cluster` blocks of the provider,
+	// by name. clusterName is set on the clientsets of a named cluster.
+	clusters    map[string]*kubeClientsets
+	clusterName string
 
 	configData *schema.ResourceData
 }
 
-func (k kubeClientsets) MainClientset() (*kubernetes.Clientset, error) {
+func (k *kubeClientsets) MainClientset() (*kubernetes.Clientset, error) {
+	if k.configErr != nil {
+		return nil, k.configErr
+	}
+	k.lock.Lock()
+	defer k.lock.Unlock()
 	if k.mainClientset != nil {
 		return k.mainClientset, nil
 	}
@@ -222,7 +443,12 @@ func (k kubeClientsets) MainClientset() (*kubernetes.Clientset, error) {
 	return k.mainClientset, nil
 }
 
-func (k kubeClientsets) AggregatorClientset() (*aggregator.Clientset, error) {
+func (k *kubeClientsets) AggregatorClientset() (*aggregator.Clientset, error) {
+	if k.configErr != nil {
+		return nil, k.configErr
+	}
+	k.lock.Lock()
+	defer k.lock.Unlock()
 	if k.aggregatorClientset != nil {
 		return k.aggregatorClientset, nil
 	}
@@ -236,51 +462,203 @@ func (k kubeClientsets) AggregatorClientset() (*aggregator.Clientset, error) {
 	return k.aggregatorClientset, nil
 }
 
+func (k *kubeClientsets) ApiextensionsClientset() (*apiextensionsclientset.Clientset, error) {
+	if k.configErr != nil {
+		return nil, k.configErr
+	}
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if k.apiextensionsClient != nil {
+		return k.apiextensionsClient, nil
+	}
+	if k.config != nil {
+		ac, err := apiextensionsclientset.NewForConfig(k.config)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to configure client: %s", err)
+		}
+		k.apiextensionsClient = ac
+	}
+	return k.apiextensionsClient, nil
+}
+
+func (k *kubeClientsets) DynamicClient() (dynamic.Interface, error) {
+	if k.configErr != nil {
+		return nil, k.configErr
+	}
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if k.dynamicClient != nil {
+		return k.dynamicClient, nil
+	}
+	if k.config != nil {
+		dc, err := dynamic.NewForConfig(k.config)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to configure dynamic client: %s", err)
+		}
+		k.dynamicClient = dc
+	}
+	return k.dynamicClient, nil
+}
+
+func (k *kubeClientsets) RESTMapper() (*restmapper.DeferredDiscoveryRESTMapper, error) {
+	if k.configErr != nil {
+		return nil, k.configErr
+	}
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if k.restMapper != nil {
+		return k.restMapper, nil
+	}
+	if k.config != nil {
+		dc, err := k.discoveryClientLocked()
+		if err != nil {
+			return nil, err
+		}
+		k.restMapper = restmapper.NewDeferredDiscoveryRESTMapper(dc)
+	}
+	return k.restMapper, nil
+}
+
+func (k *kubeClientsets) ServerSideApply() bool {
+	return k.serverSideApply
+}
+
+func (k *kubeClientsets) IgnoreAnnotations() []*regexp.Regexp {
+	return k.ignoreAnnotations
+}
+
+func (k *kubeClientsets) IgnoreLabels() []*regexp.Regexp {
+	return k.ignoreLabels
+}
+
 func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
-	// Config initialization
-	cfg, err := initializeConfiguration(d)
+	ignoreAnnotations, err := expandRegexpList(d.Get("ignore_annotations").([]interface{}))
+	if err != nil {
+		return nil, diag.Errorf("Invalid ignore_annotations: %s", err)
+	}
+	ignoreLabels, err := expandRegexpList(d.Get("ignore_labels").([]interface{}))
 	if err != nil {
+		return nil, diag.Errorf("Invalid ignore_labels: %s", err)
+	}
+
+	newClientsets := func() *kubeClientsets {
+		return &kubeClientsets{
+			serverSideApply:   d.Get("apply_mode").(string) == applyModeServerSide,
+			ignoreAnnotations: ignoreAnnotations,
+			ignoreLabels:      ignoreLabels,
+			configData:        d,
+		}
+	}
+	m := newClientsets()
+
+	// The configuration may depend on a cluster created in the same run, e.g. during
+	// the plan of an EKS or GKE cluster. Operations are then deferred until apply,
+	// rather than sent to a server inferred from an incomplete configuration.
+	if unknown, ok := ctx.Value(unknownProviderConfigKey{}).([]string); ok && len(unknown) > 0 {
+		log.Printf("[WARN] Provider configuration depends on values not known until apply: %v", unknown)
+		m.configErr = &unknownProviderConfigError{Unknown: unknown}
+		return m, nil
+	}
+
+	m.config, err = newClientConfig(d, "", terraformVersion)
+	if configErr, ok := err.(*incompleteProviderConfigError); ok {
+		log.Printf("[WARN] %s", configErr)
+		m.configErr = configErr
+	} else if err != nil {
 		return nil, diag.FromErr(err)
 	}
-	if cfg == nil {
-		// This is a TEMPORARY measure to work around https://github.com/hashicorp/terraform/issues/24055
-		// IMPORTANT: this will NOT enable a workaround of issue: https://github.com/hashicorp/terraform/issues/4149
-		// IMPORTANT: if the supplied configuration is incomplete or invalid
-		///IMPORTANT: provider operations will fail or attempt to connect to localhost endpoints
-		cfg = &restclient.Config{}
+
+	m.clusters = make(map[string]*kubeClientsets)
+	for i, v := range d.Get("cluster").([]interface{}) {
+		name := v.(map[string]interface{})["name"].(string)
+		if _, ok := m.clusters[name]; ok {
+			return nil, diag.Errorf("Cluster %q is defined more than once", name)
+		}
+
+		c := newClientsets()
+		c.clusterName = name
+		if unknown, ok := ctx.Value(unknownClusterConfigKey{}).(map[string][]string); ok && len(unknown[name]) > 0 {
+			log.Printf("[WARN] Configuration of cluster %q depends on values not known until apply: %v", name, unknown[name])
+			c.configErr = &unknownProviderConfigError{Cluster: name, Unknown: unknown[name]}
+			m.clusters[name] = c
+			continue
+		}
+		c.config, err = newClientConfig(d, fmt.Sprintf("cluster.%d.", i), terraformVersion)
+		if configErr, ok := err.(*incompleteProviderConfigError); ok {
+			configErr.Cluster = name
+			log.Printf("[WARN] %s", configErr)
+			c.configErr = configErr
+		} else if err != nil {
+			return nil, diag.Errorf("Failed to configure cluster %q: %s", name, err)
+		}
+		m.clusters[name] = c
+	}
+
+	return m, diag.Diagnostics{}
+}
+
+// newClientConfig builds the client configuration of the provider, or of one of
+// its `cluster` blocks, and wraps its transport as set in the provider.
+func newClientConfig(d *schema.ResourceData, prefix string, terraformVersion string) (*restclient.Config, error) {
+	cfg, err := initializeConfiguration(d, prefix)
+	if err != nil {
+		return nil, err
 	}
 
 	cfg.UserAgent = fmt.Sprintf("HashiCorp/1.0 Terraform/%s", terraformVersion)
 
 	if logging.IsDebugOrHigher() {
 		log.Printf("[DEBUG] Enabling HTTP requests/responses tracing")
-		cfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
 			return logging.NewTransport("Kubernetes", rt)
-		}
+		})
 	}
 
-	m := kubeClientsets{
-		config:              cfg,
-		mainClientset:       nil,
-		aggregatorClientset: nil,
-		configData:          d,
+	if v, ok := d.GetOk("qps"); ok {
+		cfg.QPS = float32(v.(float64))
 	}
-	return m, diag.Diagnostics{}
+	if v, ok := d.GetOk("burst"); ok {
+		cfg.Burst = v.(int)
+	}
+
+	retry, err := expandRetryPolicy(d.Get("retry").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("Invalid retry: %s", err)
+	}
+	if retry != nil {
+		log.Printf("[DEBUG] Retrying requests failing with %v up to %d times", retry.StatusCodes, retry.MaxAttempts)
+		cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return newRetryRoundTripper(*retry, rt)
+		})
+	}
+
+	if d.Get("apply_mode").(string) == applyModeServerSide {
+		fieldManager := d.Get("field_manager").(string)
+		forceConflicts := d.Get("force_conflicts").(bool)
+		log.Printf("[DEBUG] Using server-side apply with field manager %q", fieldManager)
+		cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return newServerSideApplyRoundTripper(fieldManager, forceConflicts, rt)
+		})
+	}
+
+	return cfg, nil
 }
 
-func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, error) {
+// initializeConfiguration builds the client configuration from the provider
+// attributes, or from the attributes of a `cluster` block when given its prefix.
+func initializeConfiguration(d *schema.ResourceData, prefix string) (*restclient.Config, error) {
 	overrides := &clientcmd.ConfigOverrides{}
 	loader := &clientcmd.ClientConfigLoadingRules{}
 
 	configPaths := []string{}
 
-	if v, ok := d.Get("config_path").(string); ok && v != "" {
+	if v, ok := d.Get(prefix + "config_path").(string); ok && v != "" {
 		configPaths = []string{v}
-	} else if v, ok := d.Get("config_paths").([]interface{}); ok && len(v) > 0 {
+	} else if v, ok := d.Get(prefix + "config_paths").([]interface{}); ok && len(v) > 0 {
 		for _, p := range v {
 			configPaths = append(configPaths, p.(string))
 		}
-	} else if v := os.Getenv("KUBE_CONFIG_PATHS"); v != "" {
+	} else if v := os.Getenv("KUBE_CONFIG_PATHS"); v != "" && prefix == "" {
 		// NOTE we have to do this here because the schema
 		// does not yet allow you to set a default for a TypeList
 		configPaths = filepath.SplitList(v)
@@ -306,9 +684,9 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, error)
 
 		ctxSuffix := "; default context"
 
-		kubectx, ctxOk := d.GetOk("config_context")
-		authInfo, authInfoOk := d.GetOk("config_context_auth_info")
-		cluster, clusterOk := d.GetOk("config_context_cluster")
+		kubectx, ctxOk := d.GetOk(prefix + "config_context")
+		authInfo, authInfoOk := d.GetOk(prefix + "config_context_auth_info")
+		cluster, clusterOk := d.GetOk(prefix + "config_context_cluster")
 		if ctxOk || authInfoOk || clusterOk {
 			ctxSuffix = "; overriden context"
 			if ctxOk {
@@ -331,16 +709,16 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, error)
 	}
 
 	// Overriding with static configuration
-	if v, ok := d.GetOk("insecure"); ok {
+	if v, ok := d.GetOk(prefix + "insecure"); ok {
 		overrides.ClusterInfo.InsecureSkipTLSVerify = v.(bool)
 	}
-	if v, ok := d.GetOk("cluster_ca_certificate"); ok {
+	if v, ok := d.GetOk(prefix + "cluster_ca_certificate"); ok {
 		overrides.ClusterInfo.CertificateAuthorityData = bytes.NewBufferString(v.(string)).Bytes()
 	}
-	if v, ok := d.GetOk("client_certificate"); ok {
+	if v, ok := d.GetOk(prefix + "client_certificate"); ok {
 		overrides.AuthInfo.ClientCertificateData = bytes.NewBufferString(v.(string)).Bytes()
 	}
-	if v, ok := d.GetOk("host"); ok {
+	if v, ok := d.GetOk(prefix + "host"); ok {
 		// Server has to be the complete address of the kubernetes cluster (scheme://hostname:port), not just the hostname,
 		// because `overrides` are processed too late to be taken into account by `defaultServerUrlFor()`.
 		// This basically replicates what defaultServerUrlFor() does with config but for overrides,
@@ -355,20 +733,26 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, error)
 
 		overrides.ClusterInfo.Server = host.String()
 	}
-	if v, ok := d.GetOk("username"); ok {
+	if v, ok := d.GetOk(prefix + "tls_server_name"); ok {
+		overrides.ClusterInfo.TLSServerName = v.(string)
+	}
+	if v, ok := d.GetOk(prefix + "proxy_url"); ok {
+		overrides.ClusterInfo.ProxyURL = v.(string)
+	}
+	if v, ok := d.GetOk(prefix + "username"); ok {
 		overrides.AuthInfo.Username = v.(string)
 	}
-	if v, ok := d.GetOk("password"); ok {
+	if v, ok := d.GetOk(prefix + "password"); ok {
 		overrides.AuthInfo.Password = v.(string)
 	}
-	if v, ok := d.GetOk("client_key"); ok {
+	if v, ok := d.GetOk(prefix + "client_key"); ok {
 		overrides.AuthInfo.ClientKeyData = bytes.NewBufferString(v.(string)).Bytes()
 	}
-	if v, ok := d.GetOk("token"); ok {
+	if v, ok := d.GetOk(prefix + "token"); ok {
 		overrides.AuthInfo.Token = v.(string)
 	}
 
-	if v, ok := d.GetOk("exec"); ok {
+	if v, ok := d.GetOk(prefix + "exec"); ok {
 		exec := &clientcmdapi.ExecConfig{}
 		if spec, ok := v.([]interface{})[0].(map[string]interface{}); ok {
 			exec.APIVersion = spec["api_version"].(string)
@@ -377,56 +761,49 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, error)
 			for kk, vv := range spec["env"].(map[string]interface{}) {
 				exec.Env = append(exec.Env, clientcmdapi.ExecEnvVar{Name: kk, Value: vv.(string)})
 			}
+			exec.InteractiveMode = clientcmdapi.ExecInteractiveMode(spec["interactive_mode"].(string))
+			exec.ProvideClusterInfo = spec["provide_cluster_info"].(bool)
 		} else {
 			return nil, fmt.Errorf("Failed to parse exec")
 		}
 		overrides.AuthInfo.Exec = exec
 	}
-
-	cc := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loader, overrides)
-	cfg, err := cc.ClientConfig()
+	oidc, err := expandOIDCTokenSource(d.Get(prefix + "oidc").([]interface{}))
 	if err != nil {
-		log.Printf("[WARN] Invalid provider configuration was supplied. Provider operations likely to fail: %v", err)
-		return nil, nil
+		return nil, fmt.Errorf("Invalid oidc: %s", err)
 	}
-
-	return cfg, nil
-}
-
-var useadmissionregistrationv1beta1 *bool
-
-func useAdmissionregistrationV1beta1(conn *kubernetes.Clientset) (bool, error) {
-	if useadmissionregistrationv1beta1 != nil {
-		return *useadmissionregistrationv1beta1, nil
+	if overrides.AuthInfo.Exec != nil && oidc != nil {
+		return nil, fmt.Errorf("Only one of exec or oidc can be set")
 	}
 
-	d := conn.Discovery()
-
-	group := "admissionregistration.k8s.io"
-
-	v1, err := apimachineryschema.ParseGroupVersion(fmt.Sprintf("%s/v1", group))
+	cc := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loader, overrides)
+	cfg, err := cc.ClientConfig()
 	if err != nil {
-		return false, err
+		return nil, &incompleteProviderConfigError{
+			Missing: missingProviderConfig(d, prefix, configPaths),
+			Err:     err,
+		}
 	}
 
-	err = discovery.ServerSupportsVersion(d, v1)
-	if err == nil {
-		log.Printf("[INFO] Using %s/v1", group)
-		useadmissionregistrationv1beta1 = ptrToBool(false)
-		return false, nil
+	if oidc != nil {
+		cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return newOIDCRoundTripper(oidc, rt)
+		})
 	}
 
-	v1beta1, err := apimachineryschema.ParseGroupVersion(fmt.Sprintf("%s/v1beta1", group))
-	if err != nil {
-		return false, err
-	}
+	return cfg, nil
+}
 
-	err = discovery.ServerSupportsVersion(d, v1beta1)
-	if err != nil {
-		return false, err
+// missingProviderConfig lists the provider attributes which have to be set
+// for the configuration to point at a cluster.
+func missingProviderConfig(d *schema.ResourceData, prefix string, configPaths []string) []string {
+	if len(configPaths) > 0 {
+		// The kube config is incomplete or doesn't have the selected context,
+		// which is described by the error of clientcmd
+		return nil
 	}
-
-	log.Printf("[INFO] Using %s/v1beta1", group)
-	useadmissionregistrationv1beta1 = ptrToBool(true)
-	return true, nil
+	if _, ok := d.GetOk(prefix + "host"); !ok {
+		return []string{"host", "config_path or config_paths"}
+	}
+	return nil
 }
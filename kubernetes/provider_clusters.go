@@ -0,0 +1,167 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// clusterConnectionAttributes are the provider attributes which can also be
+// set in a `cluster` block, to configure the connection to another cluster.
+var clusterConnectionAttributes = []string{
+	"host",
+	"username",
+	"password",
+	"insecure",
+	"client_certificate",
+	"client_key",
+	"cluster_ca_certificate",
+	"proxy_url",
+	"tls_server_name",
+	"config_path",
+	"config_paths",
+	"config_context",
+	"config_context_auth_info",
+	"config_context_cluster",
+	"token",
+	"exec",
+	"oidc",
+}
+
+// clusterSchema returns the schema of the `cluster` block of the provider,
+// made of the connection attributes of the provider itself.
+// Unlike the provider attributes, those don't default to environment variables.
+func clusterSchema(provider map[string]*schema.Schema) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the cluster, referenced by the `cluster` attribute of resources and data sources.",
+		},
+	}
+	for _, k := range clusterConnectionAttributes {
+		a := *provider[k]
+		a.DefaultFunc = nil
+		a.ConflictsWith = nil
+		s[k] = &a
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Additional clusters which resources and data sources can target with their `cluster` attribute.",
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+// clusterMeta returns the clientsets of the named cluster,
+// or those of the provider when no cluster is named.
+func clusterMeta(name string, meta interface{}) (interface{}, error) {
+	k, ok := meta.(*kubeClientsets)
+	if !ok || name == "" || name == k.clusterName {
+		return meta, nil
+	}
+	if providerConfigUnknown(k) {
+		// The clusters aren't configured until the configuration is known
+		return meta, nil
+	}
+	c, ok := k.clusters[name]
+	if !ok {
+		names := make([]string, 0, len(k.clusters))
+		for n := range k.clusters {
+			names = append(names, fmt.Sprintf("%q", n))
+		}
+		sort.Strings(names)
+		if len(names) == 0 {
+			return nil, fmt.Errorf("Cluster %q is not defined: the provider has no cluster block", name)
+		}
+		return nil, fmt.Errorf("Cluster %q is not defined, expected one of %s", name, strings.Join(names, ", "))
+	}
+	return c, nil
+}
+
+// splitClusterImportID splits an import ID of the form `<cluster>:<id>`, where
+// <cluster> is the name of a cluster block of the provider. IDs which don't
+// start with the name of a cluster block, e.g. `system:node` of a cluster role,
+// are returned as is with an empty cluster name.
+func splitClusterImportID(id string, meta interface{}) (string, string) {
+	k, ok := meta.(*kubeClientsets)
+	if !ok {
+		return "", id
+	}
+	name, rest, found := strings.Cut(id, ":")
+	if !found {
+		return "", id
+	}
+	if _, ok := k.clusters[name]; !ok {
+		return "", id
+	}
+	return name, rest
+}
+
+// addClusterToResource adds the `cluster` attribute to the resource or data source,
+// and makes its operations use the clientsets of that cluster.
+// Imports use the clientsets of the cluster named by the import ID, see splitClusterImportID.
+func addClusterToResource(r *schema.Resource, forceNew bool) {
+	r.Schema["cluster"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    forceNew,
+		Description: "Name of the provider `cluster` block to use, instead of the cluster configured by the provider.",
+	}
+
+	wrap := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			m, err := clusterMeta(d.Get("cluster").(string), meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return f(ctx, d, m)
+		}
+	}
+
+	if r.CreateContext != nil {
+		r.CreateContext = wrap(r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = wrap(r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrap(r.UpdateContext)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = wrap(r.DeleteContext)
+	}
+	if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			m, err := clusterMeta(d.Get("cluster").(string), meta)
+			if err != nil {
+				return err
+			}
+			return customizeDiff(ctx, d, m)
+		}
+	}
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		importState := r.Importer.StateContext
+		r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			cluster, id := splitClusterImportID(d.Id(), meta)
+			if cluster != "" {
+				d.SetId(id)
+				if err := d.Set("cluster", cluster); err != nil {
+					return nil, err
+				}
+			}
+			m, err := clusterMeta(cluster, meta)
+			if err != nil {
+				return nil, err
+			}
+			return importState(ctx, d, m)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+)
+
+// DiscoveryClient returns the discovery client of the cluster. Its results are
+// cached in memory for the lifetime of the provider, and shared with the RESTMapper.
+func (k *kubeClientsets) DiscoveryClient() (discovery.CachedDiscoveryInterface, error) {
+	if k.configErr != nil {
+		return nil, k.configErr
+	}
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	return k.discoveryClientLocked()
+}
+
+// discoveryClientLocked returns the discovery client of the cluster,
+// creating it on first use. The caller must hold k.lock.
+func (k *kubeClientsets) discoveryClientLocked() (discovery.CachedDiscoveryInterface, error) {
+	if k.discoveryClient != nil {
+		return k.discoveryClient, nil
+	}
+	if k.config != nil {
+		dc, err := discovery.NewDiscoveryClientForConfig(k.config)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to configure discovery client: %s", err)
+		}
+		k.discoveryClient = memory.NewMemCacheClient(dc)
+	}
+	return k.discoveryClient, nil
+}
+
+// ServerVersion returns the version of the API server, which is only
+// requested once per provider.
+func (k *kubeClientsets) ServerVersion() (*version.Info, error) {
+	dc, err := k.DiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+
+	k.discoveryLock.Lock()
+	defer k.discoveryLock.Unlock()
+	if k.serverVersion != nil {
+		return k.serverVersion, nil
+	}
+	v, err := dc.ServerVersion()
+	if err != nil {
+		return nil, err
+	}
+	k.serverVersion = v
+	return v, nil
+}
+
+// ServedVersion returns the first of the given versions of the group which
+// the API server serves the kind from. The version is looked up once per kind,
+// so all the resources of the cluster manage the kind through the same version.
+func (k *kubeClientsets) ServedVersion(group, kind string, versions ...string) (string, error) {
+	dc, err := k.DiscoveryClient()
+	if err != nil {
+		return "", err
+	}
+
+	k.discoveryLock.Lock()
+	defer k.discoveryLock.Unlock()
+	key := fmt.Sprintf("%s/%s", group, kind)
+	if v, ok := k.servedVersions[key]; ok {
+		return v, nil
+	}
+	v, err := servedVersion(dc, group, kind, versions)
+	if err != nil {
+		return "", err
+	}
+	if v == "" {
+		// The kind may have been added since the discovery results were
+		// cached, e.g. by a custom resource definition created in the same run
+		log.Printf("[DEBUG] %s isn't served from %s in the cached discovery results, refreshing them", kind, group)
+		dc.Invalidate()
+		v, err = servedVersion(dc, group, kind, versions)
+		if err != nil {
+			return "", err
+		}
+	}
+	if v == "" {
+		return "", fmt.Errorf("The server doesn't serve %s from any of the supported versions of %s: %s", kind, group, strings.Join(versions, ", "))
+	}
+	log.Printf("[INFO] Using %s/%s for %s", group, v, kind)
+	if k.servedVersions == nil {
+		k.servedVersions = make(map[string]string)
+	}
+	k.servedVersions[key] = v
+	return v, nil
+}
+
+// servedVersion returns the first of the versions of the group which the
+// API server serves the kind from, or an empty string if none does.
+func servedVersion(dc discovery.DiscoveryInterface, group, kind string, versions []string) (string, error) {
+	for _, v := range versions {
+		ok, err := serverSupportsResource(dc, fmt.Sprintf("%s/%s", group, v), kind)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return v, nil
+		}
+	}
+	return "", nil
+}
+
+// serverSupportsResource reports whether the API server serves the given kind
+// in the given group version. Groups often gain kinds over several releases,
+// so supporting the version alone doesn't guarantee the kind is available.
+func serverSupportsResource(d discovery.DiscoveryInterface, groupVersion, kind string) (bool, error) {
+	resources, err := d.ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		if errors.IsNotFound(err) || err == memory.ErrCacheNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, r := range resources.APIResources {
+		if r.Kind == kind {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// useAdmissionregistrationV1beta1 reports whether webhook configurations have
+// to be managed through admissionregistration.k8s.io/v1beta1, for clusters
+// older than 1.16 which don't serve them from admissionregistration.k8s.io/v1 yet.
+func useAdmissionregistrationV1beta1(k KubeClientsets) (bool, error) {
+	v, err := k.ServedVersion("admissionregistration.k8s.io", "ValidatingWebhookConfiguration", "v1", "v1beta1")
+	return v == "v1beta1", err
+}
+
+// useNetworkingV1beta1Ingress reports whether ingresses have to be managed
+// through networking.k8s.io/v1beta1, for clusters older than 1.19 which
+// don't serve them from networking.k8s.io/v1 yet.
+func useNetworkingV1beta1Ingress(k KubeClientsets) (bool, error) {
+	v, err := k.ServedVersion("networking.k8s.io", "Ingress", "v1", "v1beta1")
+	return v == "v1beta1", err
+}
+
+// useBatchV1beta1CronJob reports whether cron jobs have to be managed
+// through batch/v1beta1, for clusters older than 1.21 which don't serve
+// them from batch/v1 yet.
+func useBatchV1beta1CronJob(k KubeClientsets) (bool, error) {
+	v, err := k.ServedVersion("batch", "CronJob", "v1", "v1beta1")
+	return v == "v1beta1", err
+}
+
+// usePolicyV1beta1PodDisruptionBudget reports whether pod disruption budgets
+// have to be managed through policy/v1beta1, for clusters older than 1.21
+// which don't serve them from policy/v1 yet.
+func usePolicyV1beta1PodDisruptionBudget(k KubeClientsets) (bool, error) {
+	v, err := k.ServedVersion("policy", "PodDisruptionBudget", "v1", "v1beta1")
+	return v == "v1beta1", err
+}
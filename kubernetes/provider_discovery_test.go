@@ -0,0 +1,163 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	restclient "k8s.io/client-go/rest"
+)
+
+// newDiscoveryTestServer serves the discovery documents of an API server
+// serving the given kinds, by group version, and counts the requests.
+func newDiscoveryTestServer(t *testing.T, kinds map[string][]string, requests *int32) *httptest.Server {
+	srv, _ := newMutableDiscoveryTestServer(t, kinds, requests)
+	return srv
+}
+
+// newMutableDiscoveryTestServer is like newDiscoveryTestServer, and also
+// returns a function replacing the served kinds, e.g. to add a custom resource.
+func newMutableDiscoveryTestServer(t *testing.T, kinds map[string][]string, requests *int32) (*httptest.Server, func(map[string][]string)) {
+	var lock sync.Mutex
+	docs := discoveryTestDocs(t, kinds)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		lock.Lock()
+		doc, ok := docs[r.URL.Path]
+		lock.Unlock()
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(doc); err != nil {
+			t.Error(err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, func(kinds map[string][]string) {
+		lock.Lock()
+		defer lock.Unlock()
+		docs = discoveryTestDocs(t, kinds)
+	}
+}
+
+func discoveryTestDocs(t *testing.T, kinds map[string][]string) map[string]interface{} {
+	groups := map[string]*metav1.APIGroup{}
+	docs := map[string]interface{}{
+		"/api": &metav1.APIVersions{Versions: []string{"v1"}},
+		"/api/v1": &metav1.APIResourceList{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"list"}}},
+		},
+	}
+	for gv, ks := range kinds {
+		parsed, err := apimachineryschema.ParseGroupVersion(gv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		g, ok := groups[parsed.Group]
+		if !ok {
+			g = &metav1.APIGroup{Name: parsed.Group}
+			groups[parsed.Group] = g
+		}
+		g.Versions = append(g.Versions, metav1.GroupVersionForDiscovery{GroupVersion: gv, Version: parsed.Version})
+		g.PreferredVersion = g.Versions[0]
+
+		list := &metav1.APIResourceList{GroupVersion: gv}
+		for _, k := range ks {
+			list.APIResources = append(list.APIResources, metav1.APIResource{Name: k, Kind: k, Namespaced: true, Verbs: []string{"list"}})
+		}
+		docs["/apis/"+gv] = list
+	}
+	groupList := &metav1.APIGroupList{}
+	for _, g := range groups {
+		groupList.Groups = append(groupList.Groups, *g)
+	}
+	docs["/apis"] = groupList
+	return docs
+}
+
+func TestKubeClientsets_servedVersion(t *testing.T) {
+	var currentRequests, legacyRequests int32
+	current := newDiscoveryTestServer(t, map[string][]string{
+		"batch/v1":      {"Job", "CronJob"},
+		"batch/v1beta1": {"CronJob"},
+	}, &currentRequests)
+	legacy := newDiscoveryTestServer(t, map[string][]string{
+		"batch/v1":      {"Job"},
+		"batch/v1beta1": {"CronJob"},
+	}, &legacyRequests)
+
+	cases := []struct {
+		host     string
+		requests *int32
+		expected string
+	}{
+		{current.URL, &currentRequests, "v1"},
+		{legacy.URL, &legacyRequests, "v1beta1"},
+	}
+	for _, tc := range cases {
+		k := &kubeClientsets{config: &restclient.Config{Host: tc.host}}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				v, err := k.ServedVersion("batch", "CronJob", "v1", "v1beta1")
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if v != tc.expected {
+					t.Errorf("Expected %s to serve CronJob from batch/%s, got batch/%s", tc.host, tc.expected, v)
+				}
+			}()
+		}
+		wg.Wait()
+
+		requests := atomic.LoadInt32(tc.requests)
+		if _, err := k.ServedVersion("batch", "CronJob", "v1", "v1beta1"); err != nil {
+			t.Fatal(err)
+		}
+		if r := atomic.LoadInt32(tc.requests); r != requests {
+			t.Fatalf("Expected the served version to be cached, got %d more requests", r-requests)
+		}
+	}
+
+	k := &kubeClientsets{config: &restclient.Config{Host: legacy.URL}}
+	if _, err := k.ServedVersion("policy", "PodDisruptionBudget", "v1", "v1beta1"); err == nil {
+		t.Fatal("Expected an error for a kind the server doesn't serve")
+	}
+}
+
+func TestKubeClientsets_servedVersionRefresh(t *testing.T) {
+	var requests int32
+	srv, setKinds := newMutableDiscoveryTestServer(t, map[string][]string{
+		"batch/v1": {"Job"},
+	}, &requests)
+	k := &kubeClientsets{config: &restclient.Config{Host: srv.URL}}
+
+	if _, err := k.ServedVersion("example.com", "Widget", "v1"); err == nil {
+		t.Fatal("Expected an error for a kind the server doesn't serve")
+	}
+
+	// A custom resource definition adds the kind
+	setKinds(map[string][]string{
+		"batch/v1":       {"Job"},
+		"example.com/v1": {"Widget"},
+	})
+	v, err := k.ServedVersion("example.com", "Widget", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "v1" {
+		t.Fatalf("Expected Widget to be served from example.com/v1, got example.com/%s", v)
+	}
+}
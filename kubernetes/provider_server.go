@@ -0,0 +1,322 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// unknownProviderConfigKey is the context key under which the provider server
+// passes the provider attributes whose value is not known yet to providerConfigure.
+type unknownProviderConfigKey struct{}
+
+// unknownProviderConfigError is returned by the clients of a provider whose
+// configuration depends on values which are only known after apply.
+type unknownProviderConfigError struct {
+	Cluster string
+	Unknown []string
+}
+
+func (e *unknownProviderConfigError) Error() string {
+	what := "provider configuration"
+	if e.Cluster != "" {
+		what = fmt.Sprintf("configuration of cluster %q", e.Cluster)
+	}
+	return fmt.Sprintf("The %s depends on values that are not known until apply: %s. "+
+		"Resources are read once the configuration is known. Data sources can't be read before then; "+
+		"add a depends_on on the resources the configuration depends on to defer them.", what, strings.Join(e.Unknown, ", "))
+}
+
+// unknownClusterConfigKey is the context key under which the provider server passes
+// the attributes of each `cluster` block whose value is not known yet to providerConfigure.
+type unknownClusterConfigKey struct{}
+
+// incompleteProviderConfigError is returned by the clients of a provider whose
+// configuration doesn't point at a cluster.
+type incompleteProviderConfigError struct {
+	Cluster string
+	Missing []string
+	Err     error
+}
+
+func (e *incompleteProviderConfigError) Error() string {
+	what := "provider configuration"
+	if e.Cluster != "" {
+		what = fmt.Sprintf("configuration of cluster %q", e.Cluster)
+	}
+	if len(e.Missing) == 0 {
+		return fmt.Sprintf("Invalid %s: %s", what, e.Err)
+	}
+	return fmt.Sprintf("Invalid %s: missing %s: %s", what, strings.Join(e.Missing, ", "), e.Err)
+}
+
+// providerServer wraps the gRPC server of the SDK to detect provider configurations
+// depending on values which are only known after apply, e.g. the endpoint of a cluster
+// created in the same run. The SDK turns unknown values into empty ones before calling
+// the ConfigureContextFunc, which then can't tell them apart from unset attributes.
+type providerServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+}
+
+func NewProviderServer() tfprotov5.ProviderServer {
+	s := newProviderServer()
+	return newManifestObjectServer(s, s.provider.ResourcesMap[manifestResourceType])
+}
+
+func newProviderServer() *providerServer {
+	p := Provider()
+	return &providerServer{
+		ProviderServer: schema.NewGRPCProviderServer(p),
+		provider:       p,
+	}
+}
+
+func (s *providerServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	if req.Config != nil {
+		ty := schema.InternalMap(s.provider.Schema).CoreConfigSchema().ImpliedType()
+		config, err := msgpack.Unmarshal(req.Config.MsgPack, ty)
+		if err == nil {
+			unknown := unknownAttributes(config)
+			if config.IsKnown() && !config.IsNull() {
+				// Clusters whose blocks are only partly known are deferred on their own
+				if clusters, ok := unknownClusterAttributes(config.GetAttr("cluster")); ok {
+					unknown = removeString(unknown, "cluster")
+					if len(clusters) > 0 {
+						ctx = context.WithValue(ctx, unknownClusterConfigKey{}, clusters)
+					}
+				}
+			}
+			if len(unknown) > 0 {
+				ctx = context.WithValue(ctx, unknownProviderConfigKey{}, unknown)
+			}
+		}
+	}
+	return s.ProviderServer.ConfigureProvider(ctx, req)
+}
+
+// PlanResourceChange marks the computed attributes of the resources kept in their
+// prior state as unknown while the configuration of their cluster isn't known,
+// since they may change once the resources are read, e.g. when the cluster is replaced.
+func (s *providerServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp.PlannedState == nil || req.PriorState == nil || req.Config == nil {
+		return resp, err
+	}
+	r, ok := s.provider.ResourcesMap[req.TypeName]
+	if !ok {
+		return resp, nil
+	}
+	ty := r.CoreConfigSchema().ImpliedType()
+	prior, err := msgpack.Unmarshal(req.PriorState.MsgPack, ty)
+	if err != nil || prior.IsNull() {
+		return resp, nil
+	}
+	config, err := msgpack.Unmarshal(req.Config.MsgPack, ty)
+	if err != nil || config.IsNull() || !config.IsKnown() {
+		return resp, nil
+	}
+	cluster := ""
+	if v := config.GetAttr("cluster"); v.IsKnown() && !v.IsNull() {
+		cluster = v.AsString()
+	}
+	meta, err := clusterMeta(cluster, s.provider.Meta())
+	if err != nil || !providerConfigUnknown(meta) {
+		return resp, nil
+	}
+
+	planned, err := msgpack.Unmarshal(resp.PlannedState.MsgPack, ty)
+	if err != nil || planned.IsNull() {
+		return resp, nil
+	}
+	data, err := msgpack.Marshal(unknownComputedAttributes(r.Schema, config, planned), ty)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Provider configuration is not known yet, computed attributes of %s are unknown", req.TypeName)
+	resp.PlannedState = &tfprotov5.DynamicValue{MsgPack: data}
+	return resp, nil
+}
+
+// unknownComputedAttributes returns the planned value of a resource with the
+// computed attributes not set in its configuration turned unknown, down the
+// nested blocks of list attributes. Blocks themselves can't be unknown.
+func unknownComputedAttributes(m map[string]*schema.Schema, config, planned cty.Value) cty.Value {
+	if planned.IsNull() || !planned.IsKnown() {
+		return planned
+	}
+	vals := planned.AsValueMap()
+	for name, s := range m {
+		pv, ok := vals[name]
+		if !ok {
+			continue
+		}
+		cv := cty.NullVal(pv.Type())
+		if !config.IsNull() && config.IsKnown() && config.Type().HasAttribute(name) {
+			cv = config.GetAttr(name)
+		}
+		r, isBlock := s.Elem.(*schema.Resource)
+		isBlock = isBlock && (s.Optional || s.Required)
+		if !isBlock {
+			if s.Computed && cv.IsNull() {
+				vals[name] = cty.UnknownVal(pv.Type())
+			}
+			continue
+		}
+		if s.Type != schema.TypeList || pv.IsNull() || !pv.IsKnown() || pv.LengthInt() == 0 ||
+			cv.IsNull() || !cv.IsKnown() {
+			continue
+		}
+		elems := pv.AsValueSlice()
+		configElems := cv.AsValueSlice()
+		for i := range elems {
+			if i < len(configElems) {
+				elems[i] = unknownComputedAttributes(r.Schema, configElems[i], elems[i])
+			}
+		}
+		vals[name] = cty.ListVal(elems)
+	}
+	return cty.ObjectVal(vals)
+}
+
+// defaultedAttributesKey is the context key under which the provider server passes
+// the attributes of the applied resource which are not set in its configuration.
+type defaultedAttributesKey struct{}
+
+// defaultedAttribute is an attribute which isn't set in the configuration of a
+// resource, so that its value is the default of the schema.
+type defaultedAttribute struct {
+	Path  []interface{}
+	Value interface{}
+}
+
+// ApplyResourceChange passes the attributes left to their default to the resource,
+// which the SDK can't tell apart from the attributes set to the same value, so that
+// server-side apply patches leave them out.
+func (s *providerServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	if r, ok := s.provider.ResourcesMap[req.TypeName]; ok && req.Config != nil {
+		config, err := msgpack.Unmarshal(req.Config.MsgPack, r.CoreConfigSchema().ImpliedType())
+		if err == nil {
+			ctx = context.WithValue(ctx, defaultedAttributesKey{}, defaultedAttributes(r.Schema, config, nil))
+		}
+	}
+	return s.ProviderServer.ApplyResourceChange(ctx, req)
+}
+
+// defaultedAttributes returns the attributes with a default which are not set in
+// the configuration of a resource, down the nested blocks of list attributes.
+func defaultedAttributes(m map[string]*schema.Schema, v cty.Value, path []interface{}) []defaultedAttribute {
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+	var out []defaultedAttribute
+	for name, s := range m {
+		if !v.Type().HasAttribute(name) {
+			continue
+		}
+		av := v.GetAttr(name)
+		p := append(append([]interface{}{}, path...), name)
+		if av.IsNull() {
+			if s.Default != nil && !applyKeepsDefault[name] {
+				out = append(out, defaultedAttribute{Path: p, Value: s.Default})
+			}
+			continue
+		}
+		r, ok := s.Elem.(*schema.Resource)
+		if !ok || s.Type != schema.TypeList || !av.IsKnown() {
+			continue
+		}
+		for i, ev := range av.AsValueSlice() {
+			out = append(out, defaultedAttributes(r.Schema, ev, append(p, i))...)
+		}
+	}
+	return out
+}
+
+// unknownAttributes returns the names of the attributes of an object
+// which are not wholly known.
+func unknownAttributes(v cty.Value) []string {
+	var out []string
+	if !v.IsKnown() {
+		for name := range v.Type().AttributeTypes() {
+			out = append(out, name)
+		}
+	} else if !v.IsNull() {
+		for name, av := range v.AsValueMap() {
+			if !av.IsWhollyKnown() {
+				out = append(out, name)
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// unknownClusterAttributes returns the names of the attributes which are not wholly
+// known of each `cluster` block, by cluster name. It returns false when the blocks
+// themselves or their names are not known.
+func unknownClusterAttributes(v cty.Value) (map[string][]string, bool) {
+	if !v.IsKnown() {
+		return nil, false
+	}
+	out := map[string][]string{}
+	if v.IsNull() {
+		return out, true
+	}
+	for _, c := range v.AsValueSlice() {
+		if !c.IsKnown() || c.IsNull() {
+			return nil, false
+		}
+		name := c.GetAttr("name")
+		if !name.IsKnown() || name.IsNull() {
+			return nil, false
+		}
+		if unknown := unknownAttributes(c); len(unknown) > 0 {
+			out[name.AsString()] = unknown
+		}
+	}
+	return out, true
+}
+
+func removeString(list []string, s string) []string {
+	var out []string
+	for _, v := range list {
+		if v != s {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// providerConfigUnknown reports whether the configuration of the provider,
+// or of the cluster the clientsets belong to, is not known yet.
+func providerConfigUnknown(meta interface{}) bool {
+	k, ok := meta.(*kubeClientsets)
+	if !ok {
+		return false
+	}
+	_, unknown := k.configErr.(*unknownProviderConfigError)
+	return unknown
+}
+
+// deferReadUntilConfigured keeps the prior state of a resource when the configuration
+// of its cluster is not known yet, instead of failing the refresh. The plan then marks
+// its computed attributes as unknown, see PlanResourceChange, and the resource is read
+// as usual once the configuration is known at apply.
+func deferReadUntilConfigured(read schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if providerConfigUnknown(meta) && d.Id() != "" {
+			log.Printf("[DEBUG] Provider configuration is not known yet, keeping the prior state of %s", d.Id())
+			return nil
+		}
+		return read(ctx, d, meta)
+	}
+}
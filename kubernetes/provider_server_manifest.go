@@ -0,0 +1,345 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"sigs.k8s.io/yaml"
+)
+
+const manifestResourceType = "kubernetes_manifest"
+
+// manifestObjectServer wraps a provider server to accept the manifest of the
+// kubernetes_manifest resource as an HCL object, in its `object` attribute. The SDK
+// can't declare attributes of a dynamic type, so the resource gets the object encoded
+// as JSON in `manifest`, which is left null in the state kept by Terraform.
+type manifestObjectServer struct {
+	tfprotov5.ProviderServer
+	// sdkType is the type of the resource as declared to the SDK,
+	// objectType the one declared to Terraform.
+	sdkType    cty.Type
+	objectType cty.Type
+}
+
+func newManifestObjectServer(s tfprotov5.ProviderServer, r *schema.Resource) *manifestObjectServer {
+	sdkType := r.CoreConfigSchema().ImpliedType()
+	attrs := map[string]cty.Type{}
+	for name, ty := range sdkType.AttributeTypes() {
+		attrs[name] = ty
+	}
+	attrs["object"] = cty.DynamicPseudoType
+	return &manifestObjectServer{
+		ProviderServer: s,
+		sdkType:        sdkType,
+		objectType:     cty.Object(attrs),
+	}
+}
+
+func (s *manifestObjectServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if err != nil || resp.ResourceSchemas[manifestResourceType] == nil {
+		return resp, err
+	}
+	block := resp.ResourceSchemas[manifestResourceType].Block
+	block.Attributes = append(block.Attributes, &tfprotov5.SchemaAttribute{
+		Name:        "object",
+		Type:        tftypes.DynamicPseudoType,
+		Description: "A Kubernetes object as an HCL object, instead of `manifest`. It must set `apiVersion`, `kind` and `metadata.name`. Only the fields set in the object are compared against the object in the cluster.",
+		Optional:    true,
+	})
+	return resp, nil
+}
+
+func (s *manifestObjectServer) ValidateResourceTypeConfig(ctx context.Context, req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	if req.TypeName != manifestResourceType || req.Config == nil {
+		return s.ProviderServer.ValidateResourceTypeConfig(ctx, req)
+	}
+	config, object, err := s.toSDK(req.Config)
+	if err != nil {
+		return nil, err
+	}
+	v, err := msgpack.Unmarshal(req.Config.MsgPack, s.objectType)
+	if err != nil {
+		return nil, err
+	}
+	if v.IsKnown() && !v.IsNull() {
+		var summary string
+		hasManifest, hasObject := !v.GetAttr("manifest").IsNull(), !v.GetAttr("object").IsNull()
+		switch {
+		case !hasManifest && !hasObject:
+			summary = "One of `manifest` or `object` must be set"
+		case hasManifest && hasObject:
+			summary = "Only one of `manifest` or `object` can be set"
+		}
+		if summary != "" {
+			return &tfprotov5.ValidateResourceTypeConfigResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  summary,
+				}},
+			}, nil
+		}
+	}
+
+	r := *req
+	r.Config = config
+	resp, err := s.ProviderServer.ValidateResourceTypeConfig(ctx, &r)
+	if err != nil || object.IsNull() {
+		return resp, err
+	}
+	for _, d := range resp.Diagnostics {
+		d.Attribute = manifestObjectPath(d.Attribute)
+	}
+	return resp, nil
+}
+
+func (s *manifestObjectServer) UpgradeResourceState(ctx context.Context, req *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	resp, err := s.ProviderServer.UpgradeResourceState(ctx, req)
+	if err != nil || req.TypeName != manifestResourceType || resp.UpgradedState == nil {
+		return resp, err
+	}
+	// The SDK drops the attributes it doesn't know of from the state
+	object := cty.NullVal(cty.DynamicPseudoType)
+	if req.RawState != nil && len(req.RawState.JSON) > 0 {
+		attrs := map[string]json.RawMessage{}
+		if err := json.Unmarshal(req.RawState.JSON, &attrs); err != nil {
+			return nil, err
+		}
+		if raw, ok := attrs["object"]; ok {
+			object, err = ctyjson.Unmarshal(raw, cty.DynamicPseudoType)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	resp.UpgradedState, err = s.fromSDK(resp.UpgradedState, object)
+	return resp, err
+}
+
+// ReadResource keeps the object of a resource as configured unless the object
+// in the cluster differs, so that the refresh doesn't change its type.
+func (s *manifestObjectServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	if req.TypeName != manifestResourceType || req.CurrentState == nil {
+		return s.ProviderServer.ReadResource(ctx, req)
+	}
+	current, object, err := s.toSDK(req.CurrentState)
+	if err != nil {
+		return nil, err
+	}
+	r := *req
+	r.CurrentState = current
+	resp, err := s.ProviderServer.ReadResource(ctx, &r)
+	if err != nil || resp.NewState == nil {
+		return resp, err
+	}
+	if !object.IsNull() && object.IsWhollyKnown() {
+		object, err = s.refreshedObject(current, resp.NewState, object)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp.NewState, err = s.fromSDK(resp.NewState, object)
+	return resp, err
+}
+
+func (s *manifestObjectServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	if req.TypeName != manifestResourceType {
+		return s.ProviderServer.PlanResourceChange(ctx, req)
+	}
+	r := *req
+	var object cty.Value
+	var err error
+	if r.PriorState, _, err = s.toSDK(req.PriorState); err != nil {
+		return nil, err
+	}
+	if r.ProposedNewState, _, err = s.toSDK(req.ProposedNewState); err != nil {
+		return nil, err
+	}
+	if r.Config, object, err = s.toSDK(req.Config); err != nil {
+		return nil, err
+	}
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, &r)
+	if err != nil {
+		return resp, err
+	}
+	if resp.PlannedState != nil {
+		if resp.PlannedState, err = s.fromSDK(resp.PlannedState, object); err != nil {
+			return nil, err
+		}
+	}
+	if !object.IsNull() {
+		for i, p := range resp.RequiresReplace {
+			resp.RequiresReplace[i] = manifestObjectPath(p)
+		}
+	}
+	return resp, nil
+}
+
+func (s *manifestObjectServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	if req.TypeName != manifestResourceType {
+		return s.ProviderServer.ApplyResourceChange(ctx, req)
+	}
+	r := *req
+	var object cty.Value
+	var err error
+	if r.PriorState, _, err = s.toSDK(req.PriorState); err != nil {
+		return nil, err
+	}
+	if r.PlannedState, object, err = s.toSDK(req.PlannedState); err != nil {
+		return nil, err
+	}
+	if r.Config, _, err = s.toSDK(req.Config); err != nil {
+		return nil, err
+	}
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, &r)
+	if err != nil || resp.NewState == nil {
+		return resp, err
+	}
+	resp.NewState, err = s.fromSDK(resp.NewState, object)
+	return resp, err
+}
+
+func (s *manifestObjectServer) ImportResourceState(ctx context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	resp, err := s.ProviderServer.ImportResourceState(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	for _, r := range resp.ImportedResources {
+		if r.TypeName != manifestResourceType || r.State == nil {
+			continue
+		}
+		if r.State, err = s.fromSDK(r.State, cty.NullVal(cty.DynamicPseudoType)); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// toSDK turns a value of the resource as seen by Terraform into the value seen by
+// the SDK, with the object encoded in `manifest`. It returns the object as well.
+func (s *manifestObjectServer) toSDK(dv *tfprotov5.DynamicValue) (*tfprotov5.DynamicValue, cty.Value, error) {
+	object := cty.NullVal(cty.DynamicPseudoType)
+	if dv == nil {
+		return nil, object, nil
+	}
+	v, err := msgpack.Unmarshal(dv.MsgPack, s.objectType)
+	if err != nil {
+		return nil, object, err
+	}
+	var out cty.Value
+	switch {
+	case v.IsNull():
+		out = cty.NullVal(s.sdkType)
+	case !v.IsKnown():
+		out = cty.UnknownVal(s.sdkType)
+	default:
+		vals := v.AsValueMap()
+		object = vals["object"]
+		delete(vals, "object")
+		if !object.IsNull() {
+			vals["manifest"], err = manifestFromObject(object)
+			if err != nil {
+				return nil, object, err
+			}
+		}
+		out = cty.ObjectVal(vals)
+	}
+	data, err := msgpack.Marshal(out, s.sdkType)
+	if err != nil {
+		return nil, object, err
+	}
+	return &tfprotov5.DynamicValue{MsgPack: data}, object, nil
+}
+
+// fromSDK turns a value of the resource as seen by the SDK into the value seen by
+// Terraform, with the given object in place of `manifest` unless it is null.
+func (s *manifestObjectServer) fromSDK(dv *tfprotov5.DynamicValue, object cty.Value) (*tfprotov5.DynamicValue, error) {
+	v, err := msgpack.Unmarshal(dv.MsgPack, s.sdkType)
+	if err != nil {
+		return nil, err
+	}
+	var out cty.Value
+	switch {
+	case v.IsNull():
+		out = cty.NullVal(s.objectType)
+	case !v.IsKnown():
+		out = cty.UnknownVal(s.objectType)
+	default:
+		vals := v.AsValueMap()
+		if !object.IsNull() {
+			vals["manifest"] = cty.NullVal(cty.String)
+		}
+		vals["object"] = object
+		out = cty.ObjectVal(vals)
+	}
+	data, err := msgpack.Marshal(out, s.objectType)
+	if err != nil {
+		return nil, err
+	}
+	return &tfprotov5.DynamicValue{MsgPack: data}, nil
+}
+
+// refreshedObject returns the object read into the manifest of the refreshed state,
+// or the current object when the manifest didn't change.
+func (s *manifestObjectServer) refreshedObject(current, refreshed *tfprotov5.DynamicValue, object cty.Value) (cty.Value, error) {
+	oldV, err := msgpack.Unmarshal(current.MsgPack, s.sdkType)
+	if err != nil {
+		return object, err
+	}
+	newV, err := msgpack.Unmarshal(refreshed.MsgPack, s.sdkType)
+	if err != nil {
+		return object, err
+	}
+	if newV.IsNull() || !newV.IsKnown() {
+		return object, nil
+	}
+	newManifest := newV.GetAttr("manifest")
+	if newManifest.IsNull() || !newManifest.IsKnown() {
+		return object, nil
+	}
+	if suppressEquivalentManifest("manifest", oldV.GetAttr("manifest").AsString(), newManifest.AsString(), nil) {
+		return object, nil
+	}
+	return objectFromManifest(newManifest.AsString())
+}
+
+// manifestFromObject returns the JSON encoding of an object,
+// which is unknown until the object is wholly known.
+func manifestFromObject(object cty.Value) (cty.Value, error) {
+	if !object.IsWhollyKnown() {
+		return cty.UnknownVal(cty.String), nil
+	}
+	data, err := ctyjson.Marshal(object, object.Type())
+	if err != nil {
+		return cty.NilVal, err
+	}
+	return cty.StringVal(string(data)), nil
+}
+
+// objectFromManifest decodes a manifest in YAML or JSON format into an object.
+func objectFromManifest(manifest string) (cty.Value, error) {
+	data, err := yaml.YAMLToJSON([]byte(manifest))
+	if err != nil {
+		return cty.NilVal, err
+	}
+	ty, err := ctyjson.ImpliedType(data)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	return ctyjson.Unmarshal(data, ty)
+}
+
+// manifestObjectPath points the paths to `manifest` at `object` instead.
+func manifestObjectPath(p *tftypes.AttributePath) *tftypes.AttributePath {
+	steps := p.Steps()
+	if len(steps) == 0 || steps[0] != tftypes.AttributeName("manifest") {
+		return p
+	}
+	return tftypes.NewAttributePathWithSteps(append([]tftypes.AttributePathStep{tftypes.AttributeName("object")}, steps[1:]...))
+}
@@ -0,0 +1,182 @@
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testManifestObject() cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"apiVersion": cty.StringVal("v1"),
+		"kind":       cty.StringVal("ConfigMap"),
+		"metadata": cty.ObjectVal(map[string]cty.Value{
+			"name": cty.StringVal("test"),
+		}),
+		"data": cty.ObjectVal(map[string]cty.Value{
+			"replicas": cty.NumberIntVal(2),
+		}),
+	})
+}
+
+func TestManifestObjectServer_translate(t *testing.T) {
+	s := NewProviderServer().(*manifestObjectServer)
+	object := testManifestObject()
+	data, err := msgpack.Marshal(objectVal(s.objectType, map[string]cty.Value{
+		"object": object,
+	}), s.objectType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sdk, got, err := s.toSDK(&tfprotov5.DynamicValue{MsgPack: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.RawEquals(object) {
+		t.Fatalf("Expected the object %#v, got %#v", object, got)
+	}
+	v, err := msgpack.Unmarshal(sdk.MsgPack, s.sdkType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest := v.GetAttr("manifest").AsString()
+	expected := `{"apiVersion":"v1","data":{"replicas":2},"kind":"ConfigMap","metadata":{"name":"test"}}`
+	if manifest != expected {
+		t.Fatalf("Expected manifest %s, got %s", expected, manifest)
+	}
+
+	out, err := s.fromSDK(sdk, object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err = msgpack.Unmarshal(out.MsgPack, s.objectType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.GetAttr("manifest").IsNull() {
+		t.Fatalf("Expected a null manifest, got %#v", v.GetAttr("manifest"))
+	}
+	if !v.GetAttr("object").RawEquals(object) {
+		t.Fatalf("Expected the object %#v, got %#v", object, v.GetAttr("object"))
+	}
+
+	unknown := cty.ObjectVal(map[string]cty.Value{"kind": cty.UnknownVal(cty.String)})
+	manifestVal, err := manifestFromObject(unknown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifestVal.IsKnown() {
+		t.Fatalf("Expected an unknown manifest, got %#v", manifestVal)
+	}
+}
+
+func TestManifestObjectServer_validate(t *testing.T) {
+	s := NewProviderServer().(*manifestObjectServer)
+	testCases := []struct {
+		Name     string
+		Attrs    map[string]cty.Value
+		Expected string
+	}{
+		{
+			Name:     "neither",
+			Attrs:    map[string]cty.Value{},
+			Expected: "One of `manifest` or `object` must be set",
+		},
+		{
+			Name: "both",
+			Attrs: map[string]cty.Value{
+				"manifest": cty.StringVal("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\n"),
+				"object":   testManifestObject(),
+			},
+			Expected: "Only one of `manifest` or `object` can be set",
+		},
+		{
+			Name: "object",
+			Attrs: map[string]cty.Value{
+				"object": testManifestObject(),
+			},
+		},
+		{
+			Name: "invalid object",
+			Attrs: map[string]cty.Value{
+				"object": cty.ObjectVal(map[string]cty.Value{"kind": cty.StringVal("ConfigMap")}),
+			},
+			Expected: "apiVersion",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			data, err := msgpack.Marshal(objectVal(s.objectType, tc.Attrs), s.objectType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := s.ValidateResourceTypeConfig(context.Background(), &tfprotov5.ValidateResourceTypeConfigRequest{
+				TypeName: manifestResourceType,
+				Config:   &tfprotov5.DynamicValue{MsgPack: data},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.Expected == "" {
+				if len(resp.Diagnostics) > 0 {
+					t.Fatalf("Unexpected diagnostics: %#v", resp.Diagnostics[0])
+				}
+				return
+			}
+			if len(resp.Diagnostics) != 1 {
+				t.Fatalf("Expected one diagnostic, got %d", len(resp.Diagnostics))
+			}
+			d := resp.Diagnostics[0]
+			if !strings.Contains(d.Summary+d.Detail, tc.Expected) {
+				t.Fatalf("Expected %q in the diagnostic, got %q", tc.Expected, d.Summary)
+			}
+			if d.Attribute != nil && d.Attribute.String() != tftypes.NewAttributePath().WithAttributeName("object").String() {
+				t.Fatalf("Expected the diagnostic to point at object, got %s", d.Attribute)
+			}
+		})
+	}
+}
+
+func TestManifestObjectServer_upgradeState(t *testing.T) {
+	s := NewProviderServer().(*manifestObjectServer)
+	raw := `{"id":"v1/ConfigMap/default/test","manifest":null,"object":{"value":{"kind":"ConfigMap"},"type":["object",{"kind":"string"}]}}`
+	resp, err := s.UpgradeResourceState(context.Background(), &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: manifestResourceType,
+		RawState: &tfprotov5.RawState{JSON: []byte(raw)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("Unexpected diagnostics: %#v", resp.Diagnostics[0])
+	}
+	v, err := msgpack.Unmarshal(resp.UpgradedState.MsgPack, s.objectType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := cty.ObjectVal(map[string]cty.Value{"kind": cty.StringVal("ConfigMap")})
+	if !v.GetAttr("object").RawEquals(expected) {
+		t.Fatalf("Expected the object %#v, got %#v", expected, v.GetAttr("object"))
+	}
+}
+
+func TestObjectFromManifest(t *testing.T) {
+	v, err := objectFromManifest("apiVersion: v1\nkind: ConfigMap\ndata:\n  replicas: 2\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := cty.ObjectVal(map[string]cty.Value{
+		"apiVersion": cty.StringVal("v1"),
+		"kind":       cty.StringVal("ConfigMap"),
+		"data":       cty.ObjectVal(map[string]cty.Value{"replicas": cty.NumberIntVal(2)}),
+	})
+	if !v.Equals(expected).True() {
+		t.Fatalf("Expected %#v, got %#v", expected, v)
+	}
+}
@@ -0,0 +1,302 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnknownAttributes(t *testing.T) {
+	ty := cty.Object(map[string]cty.Type{
+		"host":  cty.String,
+		"token": cty.String,
+		"exec":  cty.List(cty.Object(map[string]cty.Type{"args": cty.List(cty.String)})),
+	})
+	testCases := []struct {
+		Name     string
+		Value    cty.Value
+		Expected []string
+	}{
+		{
+			"known",
+			cty.ObjectVal(map[string]cty.Value{
+				"host":  cty.StringVal("https://example.com"),
+				"token": cty.NullVal(cty.String),
+				"exec":  cty.NullVal(ty.AttributeType("exec")),
+			}),
+			nil,
+		},
+		{
+			"unknown attributes",
+			cty.ObjectVal(map[string]cty.Value{
+				"host":  cty.UnknownVal(cty.String),
+				"token": cty.StringVal("token"),
+				"exec": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"args": cty.ListVal([]cty.Value{cty.StringVal("eks"), cty.UnknownVal(cty.String)}),
+				})}),
+			}),
+			[]string{"exec", "host"},
+		},
+		{
+			"unknown object",
+			cty.UnknownVal(ty),
+			[]string{"exec", "host", "token"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			out := unknownAttributes(tc.Value)
+			if !reflect.DeepEqual(out, tc.Expected) {
+				t.Fatalf("Expected %v, got %v", tc.Expected, out)
+			}
+		})
+	}
+}
+
+func TestProviderServer_configureUnknown(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	s := newProviderServer()
+	ty := schema.InternalMap(s.provider.Schema).CoreConfigSchema().ImpliedType()
+	attrs := map[string]cty.Value{}
+	for name, at := range ty.AttributeTypes() {
+		attrs[name] = cty.NullVal(at)
+	}
+	attrs["host"] = cty.UnknownVal(cty.String)
+	attrs["cluster_ca_certificate"] = cty.UnknownVal(cty.String)
+	config, err := msgpack.Marshal(cty.ObjectVal(attrs), ty)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{
+		TerraformVersion: "1.0.0",
+		Config:           &tfprotov5.DynamicValue{MsgPack: config},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("Unexpected diagnostics: %#v", resp.Diagnostics[0])
+	}
+
+	_, err = s.provider.Meta().(KubeClientsets).MainClientset()
+	if _, ok := err.(*unknownProviderConfigError); !ok {
+		t.Fatalf("Expected an unknown configuration error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "cluster_ca_certificate, host") {
+		t.Fatalf("Expected the unknown attributes to be listed, got %q", err)
+	}
+}
+
+func TestProviderServer_planUnknownCluster(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+	ctx := context.Background()
+
+	s := newProviderServer()
+	ty := schema.InternalMap(s.provider.Schema).CoreConfigSchema().ImpliedType()
+	clusterTy := ty.AttributeType("cluster").ElementType()
+	config, err := msgpack.Marshal(objectVal(ty, map[string]cty.Value{
+		"host": cty.StringVal("https://example.com"),
+		"cluster": cty.ListVal([]cty.Value{objectVal(clusterTy, map[string]cty.Value{
+			"name": cty.StringVal("other"),
+			"host": cty.UnknownVal(cty.String),
+		})}),
+	}), ty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		TerraformVersion: "1.0.0",
+		Config:           &tfprotov5.DynamicValue{MsgPack: config},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("Unexpected diagnostics: %#v", resp.Diagnostics[0])
+	}
+	if _, err := s.provider.Meta().(KubeClientsets).MainClientset(); err != nil {
+		t.Fatalf("Expected the provider to be configured, got %v", err)
+	}
+	other, err := clusterMeta("other", s.provider.Meta())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = other.(KubeClientsets).MainClientset()
+	if _, ok := err.(*unknownProviderConfigError); !ok || !strings.Contains(err.Error(), `cluster "other"`) {
+		t.Fatalf("Expected an unknown configuration error for the cluster, got %v", err)
+	}
+
+	r := s.provider.ResourcesMap["kubernetes_config_map"]
+	rty := r.CoreConfigSchema().ImpliedType()
+	metaTy := rty.AttributeType("metadata").ElementType()
+	state := func(cluster string, computed bool) *tfprotov5.DynamicValue {
+		metadata := map[string]cty.Value{"name": cty.StringVal("test")}
+		attrs := map[string]cty.Value{"data": cty.MapVal(map[string]cty.Value{"foo": cty.StringVal("bar")})}
+		if cluster != "" {
+			attrs["cluster"] = cty.StringVal(cluster)
+		}
+		if computed {
+			attrs["id"] = cty.StringVal("default/test")
+			metadata["namespace"] = cty.StringVal("default")
+			metadata["resource_version"] = cty.StringVal("42")
+			metadata["uid"] = cty.StringVal("2f4a3e2c")
+		}
+		attrs["metadata"] = cty.ListVal([]cty.Value{objectVal(metaTy, metadata)})
+		data, err := msgpack.Marshal(objectVal(rty, attrs), rty)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &tfprotov5.DynamicValue{MsgPack: data}
+	}
+	plan := func(cluster string) cty.Value {
+		resp, err := s.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+			TypeName:         "kubernetes_config_map",
+			PriorState:       state(cluster, true),
+			ProposedNewState: state(cluster, true),
+			Config:           state(cluster, false),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Diagnostics) > 0 {
+			t.Fatalf("Unexpected diagnostics: %#v", resp.Diagnostics[0])
+		}
+		planned, err := msgpack.Unmarshal(resp.PlannedState.MsgPack, rty)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return planned.GetAttr("metadata").Index(cty.NumberIntVal(0))
+	}
+
+	if m := plan(""); !m.GetAttr("resource_version").IsKnown() {
+		t.Fatal("Expected the plan of a configured cluster to be known")
+	}
+	m := plan("other")
+	if m.GetAttr("resource_version").IsKnown() || m.GetAttr("uid").IsKnown() {
+		t.Fatalf("Expected the computed attributes to be unknown, got %#v", m)
+	}
+	if !m.GetAttr("name").RawEquals(cty.StringVal("test")) {
+		t.Fatalf("Expected the configured attributes to be kept, got %#v", m)
+	}
+}
+
+// objectVal returns an object of the given type with the given attributes, the others being null.
+func objectVal(ty cty.Type, attrs map[string]cty.Value) cty.Value {
+	vals := map[string]cty.Value{}
+	for name, at := range ty.AttributeTypes() {
+		vals[name] = cty.NullVal(at)
+		if v, ok := attrs[name]; ok {
+			vals[name] = v
+		}
+	}
+	return cty.ObjectVal(vals)
+}
+
+func TestProvider_configure_incomplete(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+	if os.Getenv("KUBERNETES_SERVICE_HOST") != "" {
+		t.Skip("The provider can always be configured inside a cluster")
+	}
+
+	p := Provider()
+	diags := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{}))
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	_, err := p.Meta().(KubeClientsets).MainClientset()
+	if _, ok := err.(*incompleteProviderConfigError); !ok {
+		t.Fatalf("Expected an incomplete configuration error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "missing host, config_path or config_paths") {
+		t.Fatalf("Expected the missing attributes to be listed, got %q", err)
+	}
+}
+
+func TestDeferReadUntilConfigured(t *testing.T) {
+	var called bool
+	read := deferReadUntilConfigured(func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		called = true
+		return nil
+	})
+	d := resourceKubernetesConfigMap().TestResourceData()
+	d.SetId("default/test")
+
+	read(context.Background(), d, &kubeClientsets{configErr: &unknownProviderConfigError{Unknown: []string{"host"}}})
+	if called {
+		t.Fatal("Expected the read to be deferred while the configuration is unknown")
+	}
+
+	read(context.Background(), d, &kubeClientsets{configErr: &incompleteProviderConfigError{}})
+	if !called {
+		t.Fatal("Expected the read to fail through the client when the configuration is incomplete")
+	}
+}
+
+func TestDefaultedAttributes(t *testing.T) {
+	m := map[string]*schema.Schema{
+		"type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "Opaque",
+		},
+		"served": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"spec": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"restart_policy": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "Always",
+					},
+					"dns_policy": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "ClusterFirst",
+					},
+				},
+			},
+		},
+	}
+	config := cty.ObjectVal(map[string]cty.Value{
+		"type":   cty.NullVal(cty.String),
+		"served": cty.NullVal(cty.Bool),
+		"spec": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"restart_policy": cty.StringVal("Never"),
+			"dns_policy":     cty.NullVal(cty.String),
+		})}),
+	})
+	out := defaultedAttributes(m, config, nil)
+	sort.Slice(out, func(i, j int) bool {
+		return fmt.Sprint(out[i].Path) < fmt.Sprint(out[j].Path)
+	})
+	expected := []defaultedAttribute{
+		{Path: []interface{}{"spec", 0, "dns_policy"}, Value: "ClusterFirst"},
+		{Path: []interface{}{"type"}, Value: "Opaque"},
+	}
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Expected %v, got %v", expected, out)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -16,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	restclient "k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
 // Global constants for testing images (reduces the number of docker pulls).
@@ -109,6 +112,325 @@ func TestProvider_configure_paths(t *testing.T) {
 	}
 }
 
+func TestProvider_configure_serverSideApply(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	os.Setenv("KUBE_CONFIG_PATH", "test-fixtures/kube-config.yaml")
+	os.Setenv("KUBE_CTX", "gcp")
+
+	rc := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"apply_mode":    "server_side",
+		"field_manager": "test-manager",
+	})
+	p := Provider()
+	diags := p.Configure(ctx, rc)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !p.Meta().(KubeClientsets).ServerSideApply() {
+		t.Fatal("Expected server-side apply to be enabled")
+	}
+}
+
+func TestProvider_configure_ignoreMetadata(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	os.Setenv("KUBE_CONFIG_PATH", "test-fixtures/kube-config.yaml")
+	os.Setenv("KUBE_CTX", "gcp")
+
+	rc := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"ignore_annotations": []interface{}{`^sidecar\.istio\.io/`, "^argocd"},
+		"ignore_labels":      []interface{}{`^topology\.`},
+	})
+	p := Provider()
+	diags := p.Configure(ctx, rc)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	kc := p.Meta().(KubeClientsets)
+	if len(kc.IgnoreAnnotations()) != 2 || !kc.IgnoreAnnotations()[0].MatchString("sidecar.istio.io/status") {
+		t.Fatalf("Unexpected ignored annotations: %v", kc.IgnoreAnnotations())
+	}
+	if len(kc.IgnoreLabels()) != 1 || !kc.IgnoreLabels()[0].MatchString("topology.kubernetes.io/zone") {
+		t.Fatalf("Unexpected ignored labels: %v", kc.IgnoreLabels())
+	}
+}
+
+func TestProvider_configure_exec(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	rc := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"host": "https://127.0.0.1:6443",
+		"exec": []interface{}{map[string]interface{}{
+			"api_version":          "client.authentication.k8s.io/v1",
+			"command":              "kubelogin",
+			"args":                 []interface{}{"get-token"},
+			"interactive_mode":     "Never",
+			"provide_cluster_info": true,
+		}},
+	})
+	p := Provider()
+	diags := p.Configure(ctx, rc)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	exec := p.Meta().(*kubeClientsets).config.ExecProvider
+	if exec == nil {
+		t.Fatal("Expected the exec plugin to be configured")
+	}
+	if exec.APIVersion != "client.authentication.k8s.io/v1" || exec.Command != "kubelogin" {
+		t.Fatalf("Unexpected exec plugin: %#v", exec)
+	}
+	if exec.InteractiveMode != clientcmdapi.NeverExecInteractiveMode {
+		t.Fatalf("Expected interactive mode %q, got %q", clientcmdapi.NeverExecInteractiveMode, exec.InteractiveMode)
+	}
+	if !exec.ProvideClusterInfo {
+		t.Fatal("Expected the cluster info to be provided to the plugin")
+	}
+}
+
+func TestProvider_configure_rateLimits(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	os.Setenv("KUBE_CONFIG_PATH", "test-fixtures/kube-config.yaml")
+	os.Setenv("KUBE_CTX", "gcp")
+
+	rc := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"qps":   50,
+		"burst": 100,
+		"retry": []interface{}{
+			map[string]interface{}{
+				"max_attempts": 3,
+				"status_codes": []interface{}{429},
+			},
+		},
+	})
+	p := Provider()
+	diags := p.Configure(ctx, rc)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	cfg := p.Meta().(*kubeClientsets).config
+	if cfg.QPS != 50 || cfg.Burst != 100 {
+		t.Fatalf("Unexpected rate limits: qps %v, burst %d", cfg.QPS, cfg.Burst)
+	}
+	if _, ok := cfg.WrapTransport(http.DefaultTransport).(*retryRoundTripper); !ok {
+		t.Fatal("Expected requests to be retried")
+	}
+}
+
+func TestProvider_configure_proxy(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	os.Setenv("KUBE_CONFIG_PATH", "test-fixtures/kube-config.yaml")
+	os.Setenv("KUBE_CTX", "gcp")
+	os.Setenv("KUBE_PROXY_URL", "http://proxy.example.com:3128")
+
+	rc := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tls_server_name": "api.internal.example.com",
+	})
+	p := Provider()
+	diags := p.Configure(ctx, rc)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	assertClientConfigProxy(t, p.Meta().(*kubeClientsets).config, "http://proxy.example.com:3128", "api.internal.example.com")
+}
+
+func TestProvider_configure_kubeconfigProxy(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	os.Setenv("KUBE_CONFIG_PATH", "test-fixtures/kube-config.yaml")
+	os.Setenv("KUBE_CTX", "proxied")
+
+	rc := terraform.NewResourceConfigRaw(map[string]interface{}{})
+	p := Provider()
+	diags := p.Configure(ctx, rc)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	assertClientConfigProxy(t, p.Meta().(*kubeClientsets).config, "socks5://bastion.example.com:1080", "api.example.com")
+}
+
+func TestProvider_configure_clusters(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	os.Setenv("KUBE_CONFIG_PATH", "test-fixtures/kube-config.yaml")
+	os.Setenv("KUBE_CTX", "gcp")
+
+	rc := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster": []interface{}{
+			map[string]interface{}{
+				"name":           "edge",
+				"config_path":    "test-fixtures/kube-config.yaml",
+				"config_context": "proxied",
+			},
+			map[string]interface{}{
+				"name":  "static",
+				"host":  "https://10.1.0.1",
+				"token": "dummy",
+			},
+		},
+	})
+	p := Provider()
+	diags := p.Configure(ctx, rc)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	hosts := map[string]string{
+		"":       "https://127.0.0.1",
+		"edge":   "https://10.0.0.1",
+		"static": "https://10.1.0.1",
+	}
+	for name, host := range hosts {
+		m, err := clusterMeta(name, p.Meta())
+		if err != nil {
+			t.Fatalf("Cluster %q: %s", name, err)
+		}
+		if h := m.(*kubeClientsets).config.Host; h != host {
+			t.Fatalf("Expected cluster %q to have host %q, got %q", name, host, h)
+		}
+	}
+
+	_, err := clusterMeta("missing", p.Meta())
+	if err == nil || !strings.Contains(err.Error(), `expected one of "edge", "static"`) {
+		t.Fatalf("Expected an error listing the defined clusters, got %v", err)
+	}
+}
+
+func TestProvider_clusterImportAndDiff(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	os.Setenv("KUBE_CONFIG_PATH", "test-fixtures/kube-config.yaml")
+	os.Setenv("KUBE_CTX", "gcp")
+
+	rc := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster": []interface{}{
+			map[string]interface{}{
+				"name":  "static",
+				"host":  "https://10.1.0.1",
+				"token": "dummy",
+			},
+		},
+	})
+	p := Provider()
+	diags := p.Configure(ctx, rc)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var host string
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				host = meta.(*kubeClientsets).config.Host
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			host = meta.(*kubeClientsets).config.Host
+			return nil
+		},
+	}
+	addClusterToResource(r, true)
+
+	cases := []struct {
+		id, expectedID, expectedCluster, expectedHost string
+	}{
+		{"static:default/example", "default/example", "static", "https://10.1.0.1"},
+		{"default/example", "default/example", "", "https://127.0.0.1"},
+		// Only the name of a cluster block is taken as a cluster
+		{"system:node", "system:node", "", "https://127.0.0.1"},
+	}
+	for _, tc := range cases {
+		d := r.TestResourceData()
+		d.SetId(tc.id)
+		out, err := r.Importer.StateContext(ctx, d, p.Meta())
+		if err != nil {
+			t.Fatalf("Import of %q: %s", tc.id, err)
+		}
+		if id := out[0].Id(); id != tc.expectedID {
+			t.Fatalf("Expected import of %q to have ID %q, got %q", tc.id, tc.expectedID, id)
+		}
+		if cluster := out[0].Get("cluster").(string); cluster != tc.expectedCluster {
+			t.Fatalf("Expected import of %q to target cluster %q, got %q", tc.id, tc.expectedCluster, cluster)
+		}
+		if host != tc.expectedHost {
+			t.Fatalf("Expected import of %q to use host %q, got %q", tc.id, tc.expectedHost, host)
+		}
+	}
+
+	host = ""
+	_, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster": "static",
+		"name":    "example",
+	}), p.Meta())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if host != "https://10.1.0.1" {
+		t.Fatalf("Expected the diff to use host %q, got %q", "https://10.1.0.1", host)
+	}
+}
+
+func TestProvider_configure_duplicateCluster(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	os.Setenv("KUBE_CONFIG_PATH", "test-fixtures/kube-config.yaml")
+	os.Setenv("KUBE_CTX", "gcp")
+
+	rc := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster": []interface{}{
+			map[string]interface{}{"name": "edge", "host": "https://10.1.0.1"},
+			map[string]interface{}{"name": "edge", "host": "https://10.1.0.2"},
+		},
+	})
+	p := Provider()
+	diags := p.Configure(ctx, rc)
+	if !diags.HasError() {
+		t.Fatal("Expected an error for a duplicate cluster name")
+	}
+}
+
+func assertClientConfigProxy(t *testing.T, cfg *restclient.Config, proxyURL, serverName string) {
+	if cfg.ServerName != serverName {
+		t.Fatalf("Expected TLS server name %q, got %q", serverName, cfg.ServerName)
+	}
+	if cfg.Proxy == nil {
+		t.Fatal("Expected a proxy to be configured")
+	}
+	req, _ := http.NewRequest("GET", cfg.Host, nil)
+	u, err := cfg.Proxy(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.String() != proxyURL {
+		t.Fatalf("Expected proxy %q, got %q", proxyURL, u)
+	}
+}
+
 func unsetEnv(t *testing.T) func() {
 	e := getEnv()
 
@@ -126,6 +448,8 @@ func unsetEnv(t *testing.T) func() {
 		"KUBE_CLUSTER_CA_CERT_DATA": e.ClusterCACertData,
 		"KUBE_INSECURE":             e.Insecure,
 		"KUBE_TOKEN":                e.Token,
+		"KUBE_PROXY_URL":            e.ProxyURL,
+		"KUBE_TLS_SERVER_NAME":      e.TLSServerName,
 	}
 
 	for k, _ := range envVars {
@@ -156,6 +480,8 @@ func getEnv() *currentEnv {
 		ClusterCACertData: os.Getenv("KUBE_CLUSTER_CA_CERT_DATA"),
 		Insecure:          os.Getenv("KUBE_INSECURE"),
 		Token:             os.Getenv("KUBE_TOKEN"),
+		ProxyURL:          os.Getenv("KUBE_PROXY_URL"),
+		TLSServerName:     os.Getenv("KUBE_TLS_SERVER_NAME"),
 	}
 	if v := os.Getenv("KUBE_CONFIG_PATH"); v != "" {
 		e.ConfigPath = v
@@ -209,11 +535,7 @@ func getClusterVersion() (*gversion.Version, error) {
 		return nil, fmt.Errorf("Provider not initialized, unable to check cluster version")
 	}
 
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return nil, err
-	}
-	serverVersion, err := conn.ServerVersion()
+	serverVersion, err := meta.(KubeClientsets).ServerVersion()
 
 	if err != nil {
 		return nil, err
@@ -419,6 +741,8 @@ type currentEnv struct {
 	ClusterCACertData string
 	Insecure          string
 	Token             string
+	ProxyURL          string
+	TLSServerName     string
 }
 
 func requiredProviders() string {
@@ -10,7 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 )
 
@@ -107,14 +108,19 @@ func resourceKubernetesAPIServiceCreate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	svc := v1.APIService{
-		ObjectMeta: metadata,
-		Spec:       expandAPIServiceSpec(d.Get("spec").([]interface{})),
-	}
+	svc := expandAPIService(d)
 
 	log.Printf("[INFO] Creating new API service: %#v", svc)
-	out, err := conn.ApiregistrationV1().APIServices().Create(ctx, &svc, meta_v1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, svc)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *v1.APIService
+	if apply {
+		out, err = conn.ApiregistrationV1().APIServices().Patch(ctx, svc.Name, types.ApplyPatchType, data, meta_v1.PatchOptions{})
+	} else {
+		out, err = conn.ApiregistrationV1().APIServices().Create(ctx, svc, meta_v1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -124,6 +130,13 @@ func resourceKubernetesAPIServiceCreate(ctx context.Context, d *schema.ResourceD
 	return resourceKubernetesAPIServiceRead(ctx, d, meta)
 }
 
+func expandAPIService(d *schema.ResourceData) *v1.APIService {
+	return &v1.APIService{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandAPIServiceSpec(d.Get("spec").([]interface{})),
+	}
+}
+
 func resourceKubernetesAPIServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesAPIServiceExists(ctx, d, meta)
 	if err != nil {
@@ -146,7 +159,7 @@ func resourceKubernetesAPIServiceRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received API service: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(svc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -175,12 +188,14 @@ func resourceKubernetesAPIServiceUpdate(ctx context.Context, d *schema.ResourceD
 			Value: expandAPIServiceSpec(d.Get("spec").([]interface{})),
 		})
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandAPIService(d), nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating service %q: %v", name, string(data))
-	out, err := conn.ApiregistrationV1().APIServices().Patch(ctx, name, pkgApi.JSONPatchType, data, meta_v1.PatchOptions{})
+	out, err := conn.ApiregistrationV1().APIServices().Patch(ctx, name, patchType, data, meta_v1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update API service: %s", err)
 	}
@@ -2,7 +2,6 @@ package kubernetes
 
 import (
 	"context"
-	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -128,9 +127,9 @@ func resourceKubernetesCertificateSigningRequestCreate(ctx context.Context, d *s
 			return updateErr
 		})
 		if retryErr != nil {
-			panic(diag.Errorf("CSR auto-approve update failed: %v", retryErr))
+			return diag.Errorf("CSR auto-approve update failed: %v", retryErr)
 		}
-		fmt.Println("CSR auto-approve update succeeded")
+		log.Printf("[INFO] CSR auto-approve update succeeded")
 	}
 
 	log.Printf("[DEBUG] Waiting for certificate to be issued")
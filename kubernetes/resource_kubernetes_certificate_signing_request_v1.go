@@ -0,0 +1,293 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+func resourceKubernetesCertificateSigningRequestV1() *schema.Resource {
+	apiDocSpec := certificatesv1.CertificateSigningRequestSpec{}.SwaggerDoc()
+	apiDocStatus := certificatesv1.CertificateSigningRequestStatus{}.SwaggerDoc()
+
+	return &schema.Resource{
+		CreateContext: resourceKubernetesCertificateSigningRequestV1Create,
+		ReadContext:   resourceKubernetesCertificateSigningRequestV1Read,
+		UpdateContext: resourceKubernetesCertificateSigningRequestV1Update,
+		DeleteContext: resourceKubernetesCertificateSigningRequestV1Delete,
+		CustomizeDiff: resourceKubernetesCertificateSigningRequestV1CustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"auto_approve": {
+				Type:        schema.TypeBool,
+				Description: "Automatically approve the CertificateSigningRequest",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+			},
+			"keep_object": {
+				Type:        schema.TypeBool,
+				Description: "Keep the CertificateSigningRequest object in the cluster once the certificate is issued, instead of deleting it right away. The object is deleted on destroy.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"renew_before_seconds": {
+				Type:         schema.TypeInt,
+				Description:  "Plan to re-issue the certificate when it expires within this many seconds. Defaults to 0, which never re-issues it.",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"ready_for_renewal": {
+				Type:        schema.TypeBool,
+				Description: "Whether the certificate expires within `renew_before_seconds` and will be re-issued.",
+				Computed:    true,
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Description: apiDocStatus["certificate"],
+				Computed:    true,
+			},
+			"metadata": metadataSchemaForceNew(metadataSchema("certificate signing request", true)),
+			"spec": {
+				ForceNew:    true,
+				Type:        schema.TypeList,
+				Description: certificatesv1.CertificateSigningRequest{}.SwaggerDoc()["spec"],
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"request": {
+							Type:        schema.TypeString,
+							Description: apiDocSpec["request"],
+							Required:    true,
+							ForceNew:    true,
+						},
+						"signer_name": {
+							Type:        schema.TypeString,
+							Description: apiDocSpec["signerName"],
+							Required:    true,
+							ForceNew:    true,
+						},
+						"expiration_seconds": {
+							Type:         schema.TypeInt,
+							Description:  "The requested duration of validity of the issued certificate. The signer may issue a certificate with a different validity duration. The minimum valid value is 600. Requires Kubernetes 1.22 or later.",
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(600),
+						},
+						"usages": {
+							Type:        schema.TypeSet,
+							Description: apiDocSpec["usages"],
+							Set:         schema.HashString,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceKubernetesCertificateSigningRequestV1CustomizeDiff forces a new
+// certificate to be requested once the current one is close to expiry.
+func resourceKubernetesCertificateSigningRequestV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("ready_for_renewal").(bool) {
+		return nil
+	}
+	err := d.SetNew("ready_for_renewal", false)
+	if err != nil {
+		return err
+	}
+	return d.ForceNew("ready_for_renewal")
+}
+
+func resourceKubernetesCertificateSigningRequestV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	spec, err := expandCertificateSigningRequestV1Spec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	csr := certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metadata,
+		Spec:       *spec,
+	}
+	log.Printf("[INFO] Creating new certificate signing request: %#v", csr)
+	newCSR, err := conn.CertificatesV1().CertificateSigningRequests().Create(ctx, &csr, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create certificate signing request: %s", err)
+	}
+
+	// Get the name, since it might have been randomly generated during create.
+	csrName := newCSR.ObjectMeta.Name
+	log.Printf("[INFO] Submitted new certificate signing request: %s", csrName)
+
+	keepObject := d.Get("keep_object").(bool)
+	if keepObject {
+		// Track the object right away, so that a failed request gets tainted and cleaned up.
+		d.SetId(csrName)
+	}
+
+	certificate, err := issueCertificateSigningRequestV1(ctx, conn, csrName, d.Get("auto_approve").(bool), d.Timeout(schema.TimeoutCreate))
+	if !keepObject {
+		log.Printf("[INFO] Deleting certificate signing request %s", csrName)
+		delErr := conn.CertificatesV1().CertificateSigningRequests().Delete(ctx, csrName, metav1.DeleteOptions{})
+		if delErr != nil && !errors.IsNotFound(delErr) {
+			log.Printf("[WARN] Failed to delete certificate signing request %s: %s", csrName, delErr)
+		}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Certificate issued for request: %s", csrName)
+
+	d.SetId(csrName)
+	err = d.Set("certificate", certificate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesCertificateSigningRequestV1Read(ctx, d, meta)
+}
+
+// issueCertificateSigningRequestV1 approves the request if asked to,
+// and waits for the signer to issue the certificate.
+func issueCertificateSigningRequestV1(ctx context.Context, conn *kubernetes.Clientset, name string, autoApprove bool, timeout time.Duration) (string, error) {
+	if autoApprove {
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			pendingCSR, err := conn.CertificatesV1().CertificateSigningRequests().Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			approval := certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateApproved,
+				Status:  corev1.ConditionTrue,
+				Reason:  "TerraformAutoApprove",
+				Message: "This CSR was approved by Terraform auto_approve.",
+			}
+			pendingCSR.Status.Conditions = append(pendingCSR.Status.Conditions, approval)
+			_, err = conn.CertificatesV1().CertificateSigningRequests().UpdateApproval(ctx, name, pendingCSR, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			return "", fmt.Errorf("CSR auto-approve update failed: %s", err)
+		}
+		log.Printf("[INFO] Certificate signing request %s approved", name)
+	}
+
+	log.Printf("[DEBUG] Waiting for certificate to be issued")
+	var certificate string
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		out, err := conn.CertificatesV1().CertificateSigningRequests().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		for _, condition := range out.Status.Conditions {
+			switch condition.Type {
+			case certificatesv1.CertificateDenied:
+				return resource.NonRetryableError(fmt.Errorf("Certificate signing request %s was denied: %s", name, condition.Message))
+			case certificatesv1.CertificateFailed:
+				return resource.NonRetryableError(fmt.Errorf("Signing certificate for request %s failed: %s", name, condition.Message))
+			}
+		}
+
+		if len(out.Status.Certificate) == 0 {
+			return resource.RetryableError(fmt.Errorf("Waiting for certificate of request %s to be issued", name))
+		}
+		certificate = string(out.Status.Certificate)
+		return nil
+	})
+	return certificate, err
+}
+
+func resourceKubernetesCertificateSigningRequestV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("keep_object").(bool) {
+		conn, err := meta.(KubeClientsets).MainClientset()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		name := d.Id()
+
+		log.Printf("[INFO] Reading certificate signing request %s", name)
+		csr, err := conn.CertificatesV1().CertificateSigningRequests().Get(ctx, name, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return diag.FromErr(err)
+		}
+		// The issued certificate lives on in state even if the cluster
+		// garbage collected the request, so only the metadata is refreshed.
+		if err == nil {
+			err = d.Set("metadata", flattenResourceMetadata(csr.ObjectMeta, d, meta))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	readyForRenewal := false
+	if renewBefore := d.Get("renew_before_seconds").(int); renewBefore > 0 && d.Get("certificate").(string) != "" {
+		notAfter, err := certificateExpiration(d.Get("certificate").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		readyForRenewal = time.Until(notAfter) < time.Duration(renewBefore)*time.Second
+		if readyForRenewal {
+			log.Printf("[INFO] Certificate of request %s expires at %s and is ready for renewal", d.Id(), notAfter)
+		}
+	}
+	err := d.Set("ready_for_renewal", readyForRenewal)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesCertificateSigningRequestV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only renew_before_seconds can change in place, and it lives in state alone
+	return resourceKubernetesCertificateSigningRequestV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesCertificateSigningRequestV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("keep_object").(bool) {
+		conn, err := meta.(KubeClientsets).MainClientset()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		name := d.Id()
+
+		log.Printf("[INFO] Deleting certificate signing request %s", name)
+		err = conn.CertificatesV1().CertificateSigningRequests().Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
@@ -0,0 +1,140 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesCertificateSigningRequestV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_certificate_signing_request_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCertificateSigningRequestV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCertificateSigningRequestV1Config_basic(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCertificateSigningRequestV1Issued(resourceName),
+					testAccCheckKubernetesCertificateSigningRequestV1ObjectExists(resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.signer_name", "kubernetes.io/kube-apiserver-client"),
+					resource.TestCheckResourceAttr(resourceName, "ready_for_renewal", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesCertificateSigningRequestV1_keepObject(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_certificate_signing_request_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCertificateSigningRequestV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCertificateSigningRequestV1Config_basic(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCertificateSigningRequestV1Issued(resourceName),
+					testAccCheckKubernetesCertificateSigningRequestV1ObjectExists(resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesCertificateSigningRequestV1Issued(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if !strings.HasPrefix(rs.Primary.Attributes["certificate"], "-----BEGIN CERTIFICATE----") {
+			return fmt.Errorf("certificate is missing cert PEM preamble from resource: %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesCertificateSigningRequestV1ObjectExists(n string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		_, err = conn.CertificatesV1().CertificateSigningRequests().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		exists := err == nil
+		if exists != expected {
+			return fmt.Errorf("Expected CertificateSigningRequest %s to exist: %t, got: %t", rs.Primary.ID, expected, exists)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesCertificateSigningRequestV1Destroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_certificate_signing_request_v1" {
+			continue
+		}
+
+		out, err := conn.CertificatesV1().CertificateSigningRequests().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err == nil {
+			if out.Name == rs.Primary.ID {
+				return fmt.Errorf("CertificateSigningRequest still exists in Kubernetes: %s", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccKubernetesCertificateSigningRequestV1Config_basic(name string, keepObject bool) string {
+	return fmt.Sprintf(`resource "kubernetes_certificate_signing_request_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  auto_approve         = true
+  keep_object          = %t
+  renew_before_seconds = 600
+  spec {
+    request            = <<EOT
+-----BEGIN CERTIFICATE REQUEST-----
+MIHSMIGBAgEAMCoxGDAWBgNVBAoTD2V4YW1wbGUgY2x1c3RlcjEOMAwGA1UEAxMF
+YWRtaW4wTjAQBgcqhkjOPQIBBgUrgQQAIQM6AASSG8S2+hQvfMq5ucngPCzK0m0C
+ImigHcF787djpF2QDbz3oQ3QsM/I7ftdjB/HHlG2a5YpqjzT0KAAMAoGCCqGSM49
+BAMCA0AAMD0CHQDErNLjX86BVfOsYh/A4zmjmGknZpc2u6/coTHqAhxcR41hEU1I
+DpNPvh30e0Js8/DYn2YUfu/pQU19
+-----END CERTIFICATE REQUEST-----
+EOT
+    signer_name        = "kubernetes.io/kube-apiserver-client"
+    expiration_seconds = 86400
+    usages             = ["client auth"]
+  }
+}
+`, name, keepObject)
+}
@@ -10,7 +10,8 @@ import (
 	api "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesClusterRole() *schema.Resource {
@@ -63,18 +64,19 @@ func resourceKubernetesClusterRoleCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	cRole := api.ClusterRole{
-		ObjectMeta: metadata,
-		Rules:      expandClusterRoleRules(d.Get("rule").([]interface{})),
-	}
-
-	if v, ok := d.GetOk("aggregation_rule"); ok {
-		cRole.AggregationRule = expandClusterRoleAggregationRule(v.([]interface{}))
-	}
+	cRole := expandClusterRole(d)
 
 	log.Printf("[INFO] Creating new cluster role: %#v", cRole)
-	out, err := conn.RbacV1().ClusterRoles().Create(ctx, &cRole, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, cRole)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.ClusterRole
+	if apply {
+		out, err = conn.RbacV1().ClusterRoles().Patch(ctx, cRole.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.RbacV1().ClusterRoles().Create(ctx, cRole, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -100,12 +102,14 @@ func resourceKubernetesClusterRoleUpdate(ctx context.Context, d *schema.Resource
 		diffOps := patchRbacAggregationRule(d)
 		ops = append(ops, diffOps...)
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandClusterRole(d), nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating ClusterRole %q: %v", name, string(data))
-	out, err := conn.RbacV1().ClusterRoles().Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.RbacV1().ClusterRoles().Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update ClusterRole: %s", err)
 	}
@@ -115,6 +119,18 @@ func resourceKubernetesClusterRoleUpdate(ctx context.Context, d *schema.Resource
 	return resourceKubernetesClusterRoleRead(ctx, d, meta)
 }
 
+func expandClusterRole(d *schema.ResourceData) *api.ClusterRole {
+	cRole := &api.ClusterRole{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Rules:      expandClusterRoleRules(d.Get("rule").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("aggregation_rule"); ok {
+		cRole.AggregationRule = expandClusterRoleAggregationRule(v.([]interface{}))
+	}
+	return cRole
+}
+
 func resourceKubernetesClusterRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesClusterRoleExists(ctx, d, meta)
 	if err != nil {
@@ -142,7 +158,7 @@ func resourceKubernetesClusterRoleRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received cluster role: %#v", cRole)
-	err = d.Set("metadata", flattenMetadata(cRole.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(cRole.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
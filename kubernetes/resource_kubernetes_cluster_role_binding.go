@@ -10,7 +10,8 @@ import (
 	api "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesClusterRoleBinding() *schema.Resource {
@@ -54,24 +55,34 @@ func resourceKubernetesClusterRoleBindingCreate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	binding := &api.ClusterRoleBinding{
-		ObjectMeta: metadata,
-		RoleRef:    expandRBACRoleRef(d.Get("role_ref").([]interface{})),
-		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
-	}
+	binding := expandClusterRoleBinding(d)
 	log.Printf("[INFO] Creating new ClusterRoleBinding: %#v", binding)
-	binding, err = conn.RbacV1().ClusterRoleBindings().Create(ctx, binding, metav1.CreateOptions{})
-
+	data, apply, err := createPatch(ctx, meta, binding)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if apply {
+		binding, err = conn.RbacV1().ClusterRoleBindings().Patch(ctx, binding.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		binding, err = conn.RbacV1().ClusterRoleBindings().Create(ctx, binding, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Submitted new ClusterRoleBinding: %#v", binding)
-	d.SetId(metadata.Name)
+	d.SetId(binding.Name)
 
 	return resourceKubernetesClusterRoleBindingRead(ctx, d, meta)
 }
 
+func expandClusterRoleBinding(d *schema.ResourceData) *api.ClusterRoleBinding {
+	return &api.ClusterRoleBinding{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		RoleRef:    expandRBACRoleRef(d.Get("role_ref").([]interface{})),
+		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
+	}
+}
+
 func resourceKubernetesClusterRoleBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesClusterRoleBindingExists(ctx, d, meta)
 	if err != nil {
@@ -95,7 +106,7 @@ func resourceKubernetesClusterRoleBindingRead(ctx context.Context, d *schema.Res
 	}
 
 	log.Printf("[INFO] Received ClusterRoleBinding: %#v", binding)
-	err = d.Set("metadata", flattenMetadata(binding.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(binding.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -130,12 +141,14 @@ func resourceKubernetesClusterRoleBindingUpdate(ctx context.Context, d *schema.R
 		diffOps := patchRbacSubject(d)
 		ops = append(ops, diffOps...)
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandClusterRoleBinding(d), nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating ClusterRoleBinding %q: %v", name, string(data))
-	out, err := conn.RbacV1().ClusterRoleBindings().Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.RbacV1().ClusterRoleBindings().Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update ClusterRoleBinding: %s", err)
 	}
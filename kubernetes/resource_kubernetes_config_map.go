@@ -10,7 +10,8 @@ import (
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesConfigMap() *schema.Resource {
@@ -46,14 +47,18 @@ func resourceKubernetesConfigMapCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	cfgMap := api.ConfigMap{
-		ObjectMeta: metadata,
-		BinaryData: expandBase64MapToByteMap(d.Get("binary_data").(map[string]interface{})),
-		Data:       expandStringMap(d.Get("data").(map[string]interface{})),
-	}
+	cfgMap := expandConfigMap(d)
 	log.Printf("[INFO] Creating new config map: %#v", cfgMap)
-	out, err := conn.CoreV1().ConfigMaps(metadata.Namespace).Create(ctx, &cfgMap, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, cfgMap)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.ConfigMap
+	if apply {
+		out, err = conn.CoreV1().ConfigMaps(cfgMap.Namespace).Patch(ctx, cfgMap.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.CoreV1().ConfigMaps(cfgMap.Namespace).Create(ctx, cfgMap, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -63,6 +68,14 @@ func resourceKubernetesConfigMapCreate(ctx context.Context, d *schema.ResourceDa
 	return resourceKubernetesConfigMapRead(ctx, d, meta)
 }
 
+func expandConfigMap(d *schema.ResourceData) *api.ConfigMap {
+	return &api.ConfigMap{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		BinaryData: expandBase64MapToByteMap(d.Get("binary_data").(map[string]interface{})),
+		Data:       expandStringMap(d.Get("data").(map[string]interface{})),
+	}
+}
+
 func resourceKubernetesConfigMapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesConfigMapExists(ctx, d, meta)
 	if err != nil {
@@ -88,7 +101,7 @@ func resourceKubernetesConfigMapRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received config map: %#v", cfgMap)
-	err = d.Set("metadata", flattenMetadata(cfgMap.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(cfgMap.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -123,13 +136,15 @@ func resourceKubernetesConfigMapUpdate(ctx context.Context, d *schema.ResourceDa
 		ops = append(ops, diffOps...)
 	}
 
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandConfigMap(d), nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 
 	log.Printf("[INFO] Updating config map %q: %v", name, string(data))
-	out, err := conn.CoreV1().ConfigMaps(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.CoreV1().ConfigMaps(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update Config Map: %s", err)
 	}
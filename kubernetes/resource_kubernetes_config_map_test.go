@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"os"
 	"reflect"
 	"regexp"
 	"testing"
@@ -284,6 +285,94 @@ func testAccCheckKubernetesConfigMapExists(n string, obj *api.ConfigMap) resourc
 	}
 }
 
+func TestAccKubernetesConfigMap_ignoreMetadata(t *testing.T) {
+	var conf api.ConfigMap
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_config_map.test"
+
+	// NOTE this test asserts that annotations and labels added outside of
+	// terraform are ignored when they match the provider ignore lists
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesConfigMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesConfigMapConfig_ignoreMetadata(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists(resourceName, &conf),
+				),
+			},
+			{
+				PreConfig: func() {
+					annotateConfigMap(t, &conf, map[string]string{"sidecar.example.com/status": "injected"}, map[string]string{"managed-by-operator": "true"})
+				},
+				Config: testAccKubernetesConfigMapConfig_ignoreMetadata(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.sidecar.example.com/inject", "true"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.app", "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesConfigMap_cluster(t *testing.T) {
+	var conf api.ConfigMap
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_config_map.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if os.Getenv("KUBE_CONFIG_PATH") == "" {
+				t.Skip("The cluster block of this test is configured with KUBE_CONFIG_PATH")
+			}
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesConfigMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesConfigMapConfig_cluster(name, os.Getenv("KUBE_CONFIG_PATH")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "cluster", "secondary"),
+					resource.TestCheckResourceAttr(resourceName, "data.one", "first"),
+				),
+			},
+		},
+	})
+}
+
+func annotateConfigMap(t *testing.T, obj *api.ConfigMap, annotations map[string]string, labels map[string]string) {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := context.TODO()
+	cm, err := conn.CoreV1().ConfigMaps(obj.GetNamespace()).Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	for k, v := range annotations {
+		cm.Annotations[k] = v
+	}
+	for k, v := range labels {
+		cm.Labels[k] = v
+	}
+	_, err = conn.CoreV1().ConfigMaps(obj.GetNamespace()).Update(ctx, cm, metav1.UpdateOptions{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func deleteConfigMap(t *testing.T, obj *api.ConfigMap) {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 	if err != nil {
@@ -431,3 +520,46 @@ func testAccKubernetesConfigMapConfig_binaryData2(prefix string) string {
 }
 `, prefix)
 }
+
+func testAccKubernetesConfigMapConfig_ignoreMetadata(name string) string {
+	return fmt.Sprintf(`provider "kubernetes" {
+  ignore_annotations = ["^sidecar\\.example\\.com/"]
+  ignore_labels      = ["^managed-by-"]
+}
+
+resource "kubernetes_config_map" "test" {
+  metadata {
+    annotations = {
+      "sidecar.example.com/inject" = "true"
+    }
+    labels = {
+      app = "test"
+    }
+    name = "%s"
+  }
+  data = {
+    one = "first"
+  }
+}
+`, name)
+}
+
+func testAccKubernetesConfigMapConfig_cluster(name, configPath string) string {
+	return fmt.Sprintf(`provider "kubernetes" {
+  cluster {
+    name        = "secondary"
+    config_path = %q
+  }
+}
+
+resource "kubernetes_config_map" "test" {
+  cluster = "secondary"
+  metadata {
+    name = "%s"
+  }
+  data = {
+    one = "first"
+  }
+}
+`, configPath, name)
+}
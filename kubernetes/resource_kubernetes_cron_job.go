@@ -10,9 +10,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"k8s.io/api/batch/v1beta1"
+	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesCronJob() *schema.Resource {
@@ -51,29 +52,49 @@ func resourceKubernetesCronJobSchemaV1() map[string]*schema.Schema {
 				Schema: cronJobSpecFields(),
 			},
 		},
+		"status": {
+			Type:        schema.TypeList,
+			Description: "Current status of the cron job",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"active": {
+						Type:        schema.TypeList,
+						Description: "Names of the currently running jobs.",
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"last_schedule_time": {
+						Type:        schema.TypeString,
+						Description: "The last time the job was successfully scheduled, in RFC 3339 format.",
+						Computed:    true,
+					},
+					"last_successful_time": {
+						Type:        schema.TypeString,
+						Description: "The last time the job successfully completed, in RFC 3339 format.",
+						Computed:    true,
+					},
+				},
+			},
+		},
 	}
 }
 
 func resourceKubernetesCronJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	metadata := expandMetadata(d.Get("metadata").([]interface{}))
 	spec, err := expandCronJobSpec(d.Get("spec").([]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	job := v1beta1.CronJob{
+	job := batchv1.CronJob{
 		ObjectMeta: metadata,
 		Spec:       spec,
 	}
 
 	log.Printf("[INFO] Creating new cron job: %#v", job)
 
-	out, err := conn.BatchV1beta1().CronJobs(metadata.Namespace).Create(ctx, &job, metav1.CreateOptions{})
+	out, err := createCronJob(ctx, meta.(KubeClientsets), &job)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -85,11 +106,6 @@ func resourceKubernetesCronJobCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesCronJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	namespace, _, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -102,14 +118,15 @@ func resourceKubernetesCronJobUpdate(ctx context.Context, d *schema.ResourceData
 	}
 	spec.JobTemplate.ObjectMeta.Annotations = metadata.Annotations
 
-	cronjob := &v1beta1.CronJob{
+	cronjob := &batchv1.CronJob{
 		ObjectMeta: metadata,
 		Spec:       spec,
 	}
 
 	log.Printf("[INFO] Updating cron job %s: %s", d.Id(), cronjob)
 
-	out, err := conn.BatchV1beta1().CronJobs(namespace).Update(ctx, cronjob, metav1.UpdateOptions{})
+	cronjob.Namespace = namespace
+	out, err := updateCronJob(ctx, meta.(KubeClientsets), cronjob)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -128,18 +145,13 @@ func resourceKubernetesCronJobRead(ctx context.Context, d *schema.ResourceData,
 		d.SetId("")
 		return diag.Diagnostics{}
 	}
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Reading cron job %s", name)
-	job, err := conn.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	job, err := getCronJob(ctx, meta.(KubeClientsets), namespace, name)
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
 		return diag.FromErr(err)
@@ -168,7 +180,7 @@ func resourceKubernetesCronJobRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(job.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -183,28 +195,28 @@ func resourceKubernetesCronJobRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	return nil
-}
-
-func resourceKubernetesCronJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
+	err = d.Set("status", flattenCronJobStatus(job.Status))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	return nil
+}
+
+func resourceKubernetesCronJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting cron job: %#v", name)
-	err = conn.BatchV1beta1().CronJobs(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = deleteCronJob(ctx, meta.(KubeClientsets), namespace, name)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := conn.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		_, err := getCronJob(ctx, meta.(KubeClientsets), namespace, name)
 		if err != nil {
 			if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 				return nil
@@ -226,18 +238,13 @@ func resourceKubernetesCronJobDelete(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesCronJobExists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return false, err
-	}
-
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return false, err
 	}
 
 	log.Printf("[INFO] Checking cron job %s", name)
-	_, err = conn.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	_, err = getCronJob(ctx, meta.(KubeClientsets), namespace, name)
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return false, nil
@@ -246,3 +253,119 @@ func resourceKubernetesCronJobExists(ctx context.Context, d *schema.ResourceData
 	}
 	return true, err
 }
+
+func createCronJob(ctx context.Context, k KubeClientsets, job *batchv1.CronJob) (*batchv1.CronJob, error) {
+	conn, err := k.MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	useV1beta1, err := useBatchV1beta1CronJob(k)
+	if err != nil {
+		return nil, err
+	}
+	if useV1beta1 {
+		if job.Spec.TimeZone != nil {
+			return nil, fmt.Errorf("time_zone requires a cluster serving batch/v1 cron jobs")
+		}
+		in := convertCronJobToV1beta1(job)
+		data, apply, err := createPatch(ctx, k, in)
+		if err != nil {
+			return nil, err
+		}
+		if apply {
+			out, err := conn.BatchV1beta1().CronJobs(job.Namespace).Patch(ctx, in.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return convertCronJobFromV1beta1(out), nil
+		}
+		out, err := conn.BatchV1beta1().CronJobs(job.Namespace).Create(ctx, in, metav1.CreateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return convertCronJobFromV1beta1(out), nil
+	}
+	data, apply, err := createPatch(ctx, k, job)
+	if err != nil {
+		return nil, err
+	}
+	if apply {
+		return conn.BatchV1().CronJobs(job.Namespace).Patch(ctx, job.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	}
+	return conn.BatchV1().CronJobs(job.Namespace).Create(ctx, job, metav1.CreateOptions{})
+}
+
+func getCronJob(ctx context.Context, k KubeClientsets, namespace, name string) (*batchv1.CronJob, error) {
+	conn, err := k.MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	useV1beta1, err := useBatchV1beta1CronJob(k)
+	if err != nil {
+		return nil, err
+	}
+	if useV1beta1 {
+		out, err := conn.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return convertCronJobFromV1beta1(out), nil
+	}
+	return conn.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+func updateCronJob(ctx context.Context, k KubeClientsets, job *batchv1.CronJob) (*batchv1.CronJob, error) {
+	conn, err := k.MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	useV1beta1, err := useBatchV1beta1CronJob(k)
+	if err != nil {
+		return nil, err
+	}
+	if useV1beta1 {
+		if job.Spec.TimeZone != nil {
+			return nil, fmt.Errorf("time_zone requires a cluster serving batch/v1 cron jobs")
+		}
+		in := convertCronJobToV1beta1(job)
+		if k.ServerSideApply() {
+			data, err := marshalApplyPatch(ctx, in)
+			if err != nil {
+				return nil, err
+			}
+			out, err := conn.BatchV1beta1().CronJobs(job.Namespace).Patch(ctx, job.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return convertCronJobFromV1beta1(out), nil
+		}
+		out, err := conn.BatchV1beta1().CronJobs(job.Namespace).Update(ctx, in, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return convertCronJobFromV1beta1(out), nil
+	}
+	if k.ServerSideApply() {
+		data, err := marshalApplyPatch(ctx, job)
+		if err != nil {
+			return nil, err
+		}
+		return conn.BatchV1().CronJobs(job.Namespace).Patch(ctx, job.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	}
+	return conn.BatchV1().CronJobs(job.Namespace).Update(ctx, job, metav1.UpdateOptions{})
+}
+
+func deleteCronJob(ctx context.Context, k KubeClientsets, namespace, name string) error {
+	conn, err := k.MainClientset()
+	if err != nil {
+		return err
+	}
+	useV1beta1, err := useBatchV1beta1CronJob(k)
+	if err != nil {
+		return err
+	}
+	if useV1beta1 {
+		return conn.BatchV1beta1().CronJobs(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	}
+	return conn.BatchV1().CronJobs(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
@@ -8,12 +8,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"k8s.io/api/batch/v1beta1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	batchv1 "k8s.io/api/batch/v1"
 )
 
 func TestAccKubernetesCronJob_basic(t *testing.T) {
-	var conf1, conf2 v1beta1.CronJob
+	var conf1, conf2 batchv1.CronJob
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 	imageName := alpineImageVersion
 
@@ -39,6 +38,8 @@ func TestAccKubernetesCronJob_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.starting_deadline_seconds", "10"),
 					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.successful_jobs_history_limit", "10"),
 					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.suspend", "true"),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "status.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "status.0.active.#", "0"),
 					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.job_template.0.spec.0.parallelism", "1"),
 					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.job_template.0.spec.0.backoff_limit", "2"),
 					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.job_template.0.spec.0.template.0.spec.0.container.0.name", "hello"),
@@ -73,7 +74,7 @@ func TestAccKubernetesCronJob_basic(t *testing.T) {
 }
 
 func TestAccKubernetesCronJob_extra(t *testing.T) {
-	var conf v1beta1.CronJob
+	var conf batchv1.CronJob
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 	imageName := alpineImageVersion
 
@@ -113,12 +114,40 @@ func TestAccKubernetesCronJob_extra(t *testing.T) {
 	})
 }
 
-func testAccCheckKubernetesCronJobDestroy(s *terraform.State) error {
-	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+func TestAccKubernetesCronJob_timeZone(t *testing.T) {
+	var conf1, conf2 batchv1.CronJob
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := alpineImageVersion
 
-	if err != nil {
-		return err
-	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.27.0")
+		},
+		IDRefreshName:     "kubernetes_cron_job.test",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCronJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCronJobConfig_timeZone(name, imageName, "Etc/UTC"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCronJobExists("kubernetes_cron_job.test", &conf1),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.time_zone", "Etc/UTC"),
+				),
+			},
+			{
+				Config: testAccKubernetesCronJobConfig_timeZone(name, imageName, "Europe/Berlin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCronJobExists("kubernetes_cron_job.test", &conf2),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.time_zone", "Europe/Berlin"),
+					testAccCheckKubernetesCronJobForceNew(&conf1, &conf2, false),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesCronJobDestroy(s *terraform.State) error {
 	ctx := context.TODO()
 
 	for _, rs := range s.RootModule().Resources {
@@ -131,7 +160,7 @@ func testAccCheckKubernetesCronJobDestroy(s *terraform.State) error {
 			return err
 		}
 
-		resp, err := conn.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		resp, err := getCronJob(ctx, testAccProvider.Meta().(KubeClientsets), namespace, name)
 		if err == nil {
 			if resp.Name == rs.Primary.ID {
 				return fmt.Errorf("CronJob still exists: %s", rs.Primary.ID)
@@ -142,17 +171,13 @@ func testAccCheckKubernetesCronJobDestroy(s *terraform.State) error {
 	return nil
 }
 
-func testAccCheckKubernetesCronJobExists(n string, obj *v1beta1.CronJob) resource.TestCheckFunc {
+func testAccCheckKubernetesCronJobExists(n string, obj *batchv1.CronJob) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
-		if err != nil {
-			return err
-		}
 		ctx := context.TODO()
 
 		namespace, name, err := idParts(rs.Primary.ID)
@@ -160,7 +185,7 @@ func testAccCheckKubernetesCronJobExists(n string, obj *v1beta1.CronJob) resourc
 			return err
 		}
 
-		out, err := conn.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		out, err := getCronJob(ctx, testAccProvider.Meta().(KubeClientsets), namespace, name)
 		if err != nil {
 			return err
 		}
@@ -295,7 +320,7 @@ func testAccKubernetesCronJobConfig_extraModified(name, imageName string) string
 }`, name, imageName)
 }
 
-func testAccCheckKubernetesCronJobForceNew(old, new *v1beta1.CronJob, wantNew bool) resource.TestCheckFunc {
+func testAccCheckKubernetesCronJobForceNew(old, new *batchv1.CronJob, wantNew bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if wantNew {
 			if old.ObjectMeta.UID != new.ObjectMeta.UID {
@@ -309,3 +334,30 @@ func testAccCheckKubernetesCronJobForceNew(old, new *v1beta1.CronJob, wantNew bo
 		return nil
 	}
 }
+
+func testAccKubernetesCronJobConfig_timeZone(name, imageName, timeZone string) string {
+	return fmt.Sprintf(`resource "kubernetes_cron_job" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    schedule  = "1 0 * * *"
+    time_zone = "%s"
+    job_template {
+      metadata {}
+      spec {
+        template {
+          metadata {}
+          spec {
+            container {
+              name = "hello"
+              image = "%s"
+              command = ["echo", "'hello'"]
+            }
+          }
+        }
+      }
+    }
+  }
+}`, name, timeZone, imageName)
+}
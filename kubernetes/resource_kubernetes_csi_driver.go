@@ -13,7 +13,8 @@ import (
 	storage "k8s.io/api/storage/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesCSIDriver() *schema.Resource {
@@ -71,13 +72,19 @@ func resourceKubernetesCSIDriverCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	CSIDriver := storage.CSIDriver{
-		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
-		Spec:       expandCSIDriverSpec(d.Get("spec").([]interface{})),
-	}
+	CSIDriver := expandCSIDriver(d)
 
 	log.Printf("[INFO] Creating new CSIDriver: %#v", CSIDriver)
-	out, err := conn.StorageV1beta1().CSIDrivers().Create(ctx, &CSIDriver, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, CSIDriver)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *storage.CSIDriver
+	if apply {
+		out, err = conn.StorageV1beta1().CSIDrivers().Patch(ctx, CSIDriver.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.StorageV1beta1().CSIDrivers().Create(ctx, CSIDriver, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -87,6 +94,13 @@ func resourceKubernetesCSIDriverCreate(ctx context.Context, d *schema.ResourceDa
 	return resourceKubernetesCSIDriverRead(ctx, d, meta)
 }
 
+func expandCSIDriver(d *schema.ResourceData) *storage.CSIDriver {
+	return &storage.CSIDriver{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandCSIDriverSpec(d.Get("spec").([]interface{})),
+	}
+}
+
 func resourceKubernetesCSIDriverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesCSIDriverExists(ctx, d, meta)
 	if err != nil {
@@ -109,7 +123,7 @@ func resourceKubernetesCSIDriverRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received CSIDriver: %#v", CSIDriver)
-	err = d.Set("metadata", flattenMetadata(CSIDriver.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(CSIDriver.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -142,12 +156,14 @@ func resourceKubernetesCSIDriverUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 		ops = append(ops, *diffOps...)
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandCSIDriver(d), nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating CSIDriver %q: %v", name, string(data))
-	out, err := conn.StorageV1beta1().CSIDrivers().Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.StorageV1beta1().CSIDrivers().Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update CSIDriver: %s", err)
 	}
@@ -0,0 +1,546 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apiextensionsopenapi "k8s.io/apiextensions-apiserver/pkg/generated/openapi"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kube-openapi/pkg/common"
+	openapispec "k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+func resourceKubernetesCustomResourceDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesCustomResourceDefinitionCreate,
+		ReadContext:   resourceKubernetesCustomResourceDefinitionRead,
+		UpdateContext: resourceKubernetesCustomResourceDefinitionUpdate,
+		DeleteContext: resourceKubernetesCustomResourceDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: resourceKubernetesCustomResourceDefinitionSchema(),
+	}
+}
+
+func resourceKubernetesCustomResourceDefinitionSchema() map[string]*schema.Schema {
+	defs := apiextensionsopenapi.GetOpenAPIDefinitions(func(string) openapispec.Ref { return openapispec.Ref{} })
+	docCRD := apiextensionsV1Doc(defs, "CustomResourceDefinition")
+	docSpec := apiextensionsV1Doc(defs, "CustomResourceDefinitionSpec")
+	docNames := apiextensionsV1Doc(defs, "CustomResourceDefinitionNames")
+	docVersion := apiextensionsV1Doc(defs, "CustomResourceDefinitionVersion")
+	docValidation := apiextensionsV1Doc(defs, "CustomResourceValidation")
+	docSubresources := apiextensionsV1Doc(defs, "CustomResourceSubresources")
+	docScale := apiextensionsV1Doc(defs, "CustomResourceSubresourceScale")
+	docColumn := apiextensionsV1Doc(defs, "CustomResourceColumnDefinition")
+	docConversion := apiextensionsV1Doc(defs, "CustomResourceConversion")
+	docWebhook := apiextensionsV1Doc(defs, "WebhookConversion")
+
+	// The name of a custom resource definition must be `<plural>.<group>`
+	metaSchema := metadataSchema("custom resource definition", false)
+	nameField := metaSchema.Elem.(*schema.Resource).Schema["name"]
+	nameField.Optional = false
+	nameField.Computed = false
+	nameField.Required = true
+
+	return map[string]*schema.Schema{
+		"metadata": metaSchema,
+		"spec": {
+			Type:        schema.TypeList,
+			Description: docCRD["spec"],
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"group": {
+						Type:        schema.TypeString,
+						Description: docSpec["group"],
+						Required:    true,
+						ForceNew:    true,
+					},
+					"scope": {
+						Type:        schema.TypeString,
+						Description: docSpec["scope"],
+						Required:    true,
+						ForceNew:    true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(apiextensionsv1.ClusterScoped),
+							string(apiextensionsv1.NamespaceScoped),
+						}, false),
+					},
+					"names": {
+						Type:        schema.TypeList,
+						Description: docSpec["names"],
+						Required:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"plural": {
+									Type:        schema.TypeString,
+									Description: docNames["plural"],
+									Required:    true,
+									ForceNew:    true,
+								},
+								"singular": {
+									Type:        schema.TypeString,
+									Description: docNames["singular"],
+									Optional:    true,
+									Computed:    true,
+								},
+								"kind": {
+									Type:        schema.TypeString,
+									Description: docNames["kind"],
+									Required:    true,
+								},
+								"list_kind": {
+									Type:        schema.TypeString,
+									Description: docNames["listKind"],
+									Optional:    true,
+									Computed:    true,
+								},
+								"short_names": {
+									Type:        schema.TypeList,
+									Description: docNames["shortNames"],
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+								},
+								"categories": {
+									Type:        schema.TypeList,
+									Description: docNames["categories"],
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+								},
+							},
+						},
+					},
+					"version": {
+						Type:        schema.TypeList,
+						Description: docSpec["versions"],
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:        schema.TypeString,
+									Description: docVersion["name"],
+									Required:    true,
+								},
+								"served": {
+									Type:        schema.TypeBool,
+									Description: docVersion["served"],
+									Optional:    true,
+									Default:     true,
+								},
+								"storage": {
+									Type:        schema.TypeBool,
+									Description: docVersion["storage"],
+									Required:    true,
+								},
+								"deprecated": {
+									Type:        schema.TypeBool,
+									Description: docVersion["deprecated"],
+									Optional:    true,
+								},
+								"deprecation_warning": {
+									Type:        schema.TypeString,
+									Description: docVersion["deprecationWarning"],
+									Optional:    true,
+								},
+								"schema": {
+									Type:             schema.TypeString,
+									Description:      docValidation["openAPIV3Schema"] + " Given as JSON or YAML.",
+									Optional:         true,
+									ValidateFunc:     validateOpenAPIV3Schema,
+									DiffSuppressFunc: suppressEquivalentOpenAPIV3Schema,
+								},
+								"subresources": {
+									Type:        schema.TypeList,
+									Description: docVersion["subresources"],
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"status": {
+												Type:        schema.TypeBool,
+												Description: docSubresources["status"],
+												Optional:    true,
+											},
+											"scale": {
+												Type:        schema.TypeList,
+												Description: docSubresources["scale"],
+												Optional:    true,
+												MaxItems:    1,
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"spec_replicas_path": {
+															Type:        schema.TypeString,
+															Description: docScale["specReplicasPath"],
+															Required:    true,
+														},
+														"status_replicas_path": {
+															Type:        schema.TypeString,
+															Description: docScale["statusReplicasPath"],
+															Required:    true,
+														},
+														"label_selector_path": {
+															Type:        schema.TypeString,
+															Description: docScale["labelSelectorPath"],
+															Optional:    true,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								"additional_printer_column": {
+									Type:        schema.TypeList,
+									Description: docVersion["additionalPrinterColumns"],
+									Optional:    true,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"name": {
+												Type:        schema.TypeString,
+												Description: docColumn["name"],
+												Required:    true,
+											},
+											"type": {
+												Type:        schema.TypeString,
+												Description: docColumn["type"],
+												Required:    true,
+											},
+											"format": {
+												Type:        schema.TypeString,
+												Description: docColumn["format"],
+												Optional:    true,
+											},
+											"description": {
+												Type:        schema.TypeString,
+												Description: docColumn["description"],
+												Optional:    true,
+											},
+											"priority": {
+												Type:        schema.TypeInt,
+												Description: docColumn["priority"],
+												Optional:    true,
+											},
+											"json_path": {
+												Type:        schema.TypeString,
+												Description: docColumn["jsonPath"],
+												Required:    true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"conversion": {
+						Type:        schema.TypeList,
+						Description: docSpec["conversion"],
+						Optional:    true,
+						Computed:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"strategy": {
+									Type:        schema.TypeString,
+									Description: docConversion["strategy"],
+									Optional:    true,
+									Default:     string(apiextensionsv1.NoneConverter),
+									ValidateFunc: validation.StringInSlice([]string{
+										string(apiextensionsv1.NoneConverter),
+										string(apiextensionsv1.WebhookConverter),
+									}, false),
+								},
+								"webhook": {
+									Type:        schema.TypeList,
+									Description: docConversion["webhook"],
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"conversion_review_versions": {
+												Type:        schema.TypeList,
+												Description: docWebhook["conversionReviewVersions"],
+												Required:    true,
+												Elem:        &schema.Schema{Type: schema.TypeString},
+											},
+											"client_config": {
+												Type:        schema.TypeList,
+												Description: docWebhook["clientConfig"],
+												Optional:    true,
+												MaxItems:    1,
+												Elem: &schema.Resource{
+													Schema: webhookClientConfigFields(),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"preserve_unknown_fields": {
+						Type:        schema.TypeBool,
+						Description: docSpec["preserveUnknownFields"],
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesCustomResourceDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).ApiextensionsClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	crd, err := expandCustomResourceDefinition(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating new custom resource definition: %#v", crd)
+	data, apply, err := createPatch(ctx, meta, crd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *apiextensionsv1.CustomResourceDefinition
+	if apply {
+		out, err = conn.ApiextensionsV1().CustomResourceDefinitions().Patch(ctx, crd.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.ApiextensionsV1().CustomResourceDefinitions().Create(ctx, crd, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return diag.Errorf("Failed to create custom resource definition '%s' because: %s", crd.Name, err)
+	}
+	log.Printf("[INFO] Submitted new custom resource definition: %#v", out)
+	d.SetId(out.Name)
+
+	err = waitForCustomResourceDefinitionEstablished(ctx, conn, out.Name, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesCustomResourceDefinitionRead(ctx, d, meta)
+}
+
+func expandCustomResourceDefinition(d *schema.ResourceData) (*apiextensionsv1.CustomResourceDefinition, error) {
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	spec, err := expandCustomResourceDefinitionSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metadata,
+		Spec:       spec,
+	}, nil
+}
+
+func resourceKubernetesCustomResourceDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceKubernetesCustomResourceDefinitionExists(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	conn, err := meta.(KubeClientsets).ApiextensionsClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Reading custom resource definition %s", name)
+	crd, err := conn.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read custom resource definition '%s' because: %s", name, err)
+	}
+	log.Printf("[INFO] Received custom resource definition: %#v", crd)
+
+	err = d.Set("metadata", flattenResourceMetadata(crd.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	spec, err := flattenCustomResourceDefinitionSpec(crd.Spec)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("spec", spec)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesCustomResourceDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).ApiextensionsClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec") {
+		spec, err := expandCustomResourceDefinitionSpec(d.Get("spec").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: spec,
+		})
+	}
+
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandCustomResourceDefinition(d)
+	})
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating custom resource definition %q: %v", name, string(data))
+	out, err := conn.ApiextensionsV1().CustomResourceDefinitions().Patch(ctx, name, patchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update custom resource definition: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated custom resource definition: %#v", out)
+
+	err = waitForCustomResourceDefinitionEstablished(ctx, conn, name, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesCustomResourceDefinitionRead(ctx, d, meta)
+}
+
+func resourceKubernetesCustomResourceDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).ApiextensionsClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Deleting custom resource definition: %#v", name)
+	err = conn.ApiextensionsV1().CustomResourceDefinitions().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete custom resource definition %s because: %s", name, err)
+	}
+
+	// The API server removes the custom objects of the definition
+	// before its finalizer lets the definition itself go
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		crd, err := conn.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("Custom resource definition (%s) still exists, waiting for finalizers %v", name, crd.Finalizers)
+		return resource.RetryableError(e)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Custom resource definition %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesCustomResourceDefinitionExists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn, err := meta.(KubeClientsets).ApiextensionsClientset()
+	if err != nil {
+		return false, err
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Checking custom resource definition %s", name)
+	_, err = conn.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+// waitForCustomResourceDefinitionEstablished waits until the API server serves
+// the custom resources, so that objects of the new kinds can be created right away.
+func waitForCustomResourceDefinitionEstablished(ctx context.Context, conn *apiextensionsclientset.Clientset, name string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		crd, err := conn.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		established, namesAccepted := false, false
+		for _, c := range crd.Status.Conditions {
+			switch c.Type {
+			case apiextensionsv1.Established:
+				established = c.Status == apiextensionsv1.ConditionTrue
+			case apiextensionsv1.NamesAccepted:
+				if c.Status == apiextensionsv1.ConditionFalse {
+					return resource.NonRetryableError(fmt.Errorf("Names of custom resource definition %s were not accepted: %s", name, c.Message))
+				}
+				namesAccepted = c.Status == apiextensionsv1.ConditionTrue
+			}
+		}
+		if established && namesAccepted {
+			return nil
+		}
+
+		log.Printf("[INFO] Custom resource definition %s is not established yet", name)
+		return resource.RetryableError(fmt.Errorf("Custom resource definition %s is not established yet (established: %t, names accepted: %t)", name, established, namesAccepted))
+	})
+}
+
+// apiextensionsV1Doc returns the descriptions of the fields of an apiextensions/v1 type
+// by their JSON name, like the SwaggerDoc methods of the core API types. Those aren't
+// generated for apiextensions, so the descriptions are taken from its OpenAPI definitions.
+func apiextensionsV1Doc(defs map[string]common.OpenAPIDefinition, typeName string) map[string]string {
+	s := defs["k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1."+typeName].Schema
+	doc := map[string]string{"": s.Description}
+	for name, p := range s.Properties {
+		doc[name] = p.Description
+	}
+	return doc
+}
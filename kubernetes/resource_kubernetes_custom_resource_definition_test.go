@@ -0,0 +1,245 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesCustomResourceDefinition_basic(t *testing.T) {
+	var conf apiextensionsv1.CustomResourceDefinition
+	plural := fmt.Sprintf("tfacctest%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	group := "terraform.example.com"
+	name := fmt.Sprintf("%s.%s", plural, group)
+	resourceName := "kubernetes_custom_resource_definition.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCustomResourceDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCustomResourceDefinitionConfig_basic(plural, group),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceDefinitionExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.group", group),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scope", "Namespaced"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.names.0.plural", plural),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.names.0.kind", "Widget"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.names.0.list_kind", "WidgetList"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.0.name", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.0.served", "true"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.0.storage", "true"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.conversion.0.strategy", "None"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesCustomResourceDefinitionConfig_modified(plural, group),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceDefinitionExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.names.0.short_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.names.0.short_names.0", "wg"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.0.name", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.0.subresources.0.status", "true"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.0.subresources.0.scale.0.spec_replicas_path", ".spec.replicas"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.0.additional_printer_column.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.0.additional_printer_column.0.json_path", ".spec.size"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.1.name", "v1beta1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.1.storage", "false"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.1.deprecated", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesCustomResourceDefinitionDestroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).ApiextensionsClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_custom_resource_definition" {
+			continue
+		}
+
+		name := rs.Primary.ID
+
+		resp, err := conn.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			if resp.Name == name {
+				return fmt.Errorf("Custom resource definition still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesCustomResourceDefinitionExists(n string, obj *apiextensionsv1.CustomResourceDefinition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).ApiextensionsClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		name := rs.Primary.ID
+
+		out, err := conn.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesCustomResourceDefinitionConfig_basic(plural, group string) string {
+	return fmt.Sprintf(`resource "kubernetes_custom_resource_definition" "test" {
+  metadata {
+    name   = "%s.%s"
+    labels = {
+      TestLabelOne = "one"
+    }
+  }
+
+  spec {
+    group = "%s"
+    scope = "Namespaced"
+
+    names {
+      plural = "%s"
+      kind   = "Widget"
+    }
+
+    version {
+      name    = "v1"
+      storage = true
+      schema = jsonencode({
+        type = "object"
+        properties = {
+          spec = {
+            type = "object"
+            properties = {
+              size = { type = "string" }
+            }
+          }
+        }
+      })
+    }
+  }
+}
+`, plural, group, group, plural)
+}
+
+func testAccKubernetesCustomResourceDefinitionConfig_modified(plural, group string) string {
+	return fmt.Sprintf(`resource "kubernetes_custom_resource_definition" "test" {
+  metadata {
+    name   = "%s.%s"
+    labels = {
+      TestLabelOne = "one"
+    }
+  }
+
+  spec {
+    group = "%s"
+    scope = "Namespaced"
+
+    names {
+      plural      = "%s"
+      kind        = "Widget"
+      short_names = ["wg"]
+    }
+
+    version {
+      name    = "v1"
+      storage = true
+      schema  = <<-EOT
+        type: object
+        properties:
+          spec:
+            type: object
+            properties:
+              size:
+                type: string
+              replicas:
+                type: integer
+          status:
+            type: object
+            properties:
+              replicas:
+                type: integer
+      EOT
+
+      subresources {
+        status = true
+        scale {
+          spec_replicas_path   = ".spec.replicas"
+          status_replicas_path = ".status.replicas"
+        }
+      }
+
+      additional_printer_column {
+        name      = "Size"
+        type      = "string"
+        json_path = ".spec.size"
+      }
+    }
+
+    version {
+      name       = "v1beta1"
+      storage    = false
+      deprecated = true
+      schema = jsonencode({
+        type                                   = "object"
+        "x-kubernetes-preserve-unknown-fields" = true
+      })
+    }
+  }
+}
+`, plural, group, group, plural)
+}
+
+func TestResourceKubernetesCustomResourceDefinitionSchema_descriptions(t *testing.T) {
+	var check func(prefix string, m map[string]*schema.Schema)
+	check = func(prefix string, m map[string]*schema.Schema) {
+		for name, s := range m {
+			if s.Description == "" {
+				t.Errorf("Missing description of %s%s", prefix, name)
+			}
+			if r, ok := s.Elem.(*schema.Resource); ok {
+				check(prefix+name+".", r.Schema)
+			}
+		}
+	}
+	check("", resourceKubernetesCustomResourceDefinitionSchema())
+}
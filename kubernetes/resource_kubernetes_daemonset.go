@@ -2,21 +2,19 @@ package kubernetes
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"regexp"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesDaemonSet() *schema.Resource {
@@ -140,30 +138,25 @@ func resourceKubernetesDaemonSetCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}))
+	daemonset, err := expandDaemonSet(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	daemonset := appsv1.DaemonSet{
-		ObjectMeta: metadata,
-		Spec:       spec,
-	}
-
 	log.Printf("[INFO] Creating new daemonset: %#v", daemonset)
 
-	out, err := conn.AppsV1().DaemonSets(metadata.Namespace).Create(ctx, &daemonset, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, daemonset)
 	if err != nil {
-		return diag.Errorf("Failed to create daemonset: %s", err)
+		return diag.FromErr(err)
 	}
-
-	if d.Get("wait_for_rollout").(bool) {
-		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
-			waitForDaemonSetReplicasFunc(ctx, conn, metadata.Namespace, metadata.Name))
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	var out *appsv1.DaemonSet
+	if apply {
+		out, err = conn.AppsV1().DaemonSets(daemonset.Namespace).Patch(ctx, daemonset.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.AppsV1().DaemonSets(daemonset.Namespace).Create(ctx, daemonset, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return diag.Errorf("Failed to create daemonset: %s", err)
 	}
 
 	d.SetId(buildId(out.ObjectMeta))
@@ -197,29 +190,34 @@ func resourceKubernetesDaemonSetUpdate(ctx context.Context, d *schema.ResourceDa
 			Value: spec,
 		})
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandDaemonSet(d)
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating daemonset: %q", name)
 
-	out, err := conn.AppsV1().DaemonSets(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.AppsV1().DaemonSets(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update daemonset: %s", err)
 	}
 	log.Printf("[INFO] Submitted updated daemonset: %#v", out)
 
-	if d.Get("wait_for_rollout").(bool) {
-		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
-			waitForDaemonSetReplicasFunc(ctx, conn, namespace, name))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-
 	return resourceKubernetesDaemonSetRead(ctx, d, meta)
 }
 
+func expandDaemonSet(d *schema.ResourceData) (*appsv1.DaemonSet, error) {
+	spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.DaemonSet{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       spec,
+	}, nil
+}
+
 func resourceKubernetesDaemonSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesDaemonSetExists(ctx, d, meta)
 	if err != nil {
@@ -251,7 +249,7 @@ func resourceKubernetesDaemonSetRead(ctx context.Context, d *schema.ResourceData
 	}
 	log.Printf("[INFO] Received daemonset: %#v", daemonset)
 
-	err = d.Set("metadata", flattenMetadata(daemonset.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(daemonset.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -313,23 +311,3 @@ func resourceKubernetesDaemonSetExists(ctx context.Context, d *schema.ResourceDa
 	}
 	return true, err
 }
-
-func waitForDaemonSetReplicasFunc(ctx context.Context, conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
-	return func() *resource.RetryError {
-		daemonSet, err := conn.AppsV1().DaemonSets(ns).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		desiredReplicas := daemonSet.Status.DesiredNumberScheduled
-		log.Printf("[DEBUG] Current number of labelled replicas of %q: %d (of %d)\n",
-			daemonSet.GetName(), daemonSet.Status.CurrentNumberScheduled, desiredReplicas)
-
-		if daemonSet.Status.CurrentNumberScheduled == desiredReplicas {
-			return nil
-		}
-
-		return resource.RetryableError(fmt.Errorf("Waiting for %d replicas of %q to be scheduled (%d)",
-			desiredReplicas, daemonSet.GetName(), daemonSet.Status.CurrentNumberScheduled))
-	}
-}
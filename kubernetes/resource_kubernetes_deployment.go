@@ -15,8 +15,8 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes"
 )
 
 const (
@@ -219,36 +219,28 @@ func resourceKubernetesDeploymentCreate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
+	deployment, err := expandDeployment(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	deployment := appsv1.Deployment{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
-
 	log.Printf("[INFO] Creating new deployment: %#v", deployment)
-	out, err := conn.AppsV1().Deployments(metadata.Namespace).Create(ctx, &deployment, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, deployment)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *appsv1.Deployment
+	if apply {
+		out, err = conn.AppsV1().Deployments(deployment.Namespace).Patch(ctx, deployment.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.AppsV1().Deployments(deployment.Namespace).Create(ctx, deployment, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create deployment: %s", err)
 	}
 
 	d.SetId(buildId(out.ObjectMeta))
 
-	log.Printf("[DEBUG] Waiting for deployment %s to schedule %d replicas", d.Id(), *out.Spec.Replicas)
-
-	if d.Get("wait_for_rollout").(bool) {
-		log.Printf("[INFO] Waiting for deployment %s/%s to rollout", out.ObjectMeta.Namespace, out.ObjectMeta.Name)
-		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
-			waitForDeploymentReplicasFunc(ctx, conn, out.GetNamespace(), out.GetName()))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-
 	log.Printf("[INFO] Submitted new deployment: %#v", out)
 
 	return resourceKubernetesDeploymentRead(ctx, d, meta)
@@ -289,29 +281,33 @@ func resourceKubernetesDeploymentUpdate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandDeployment(d)
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating deployment %q: %v", name, string(data))
-	out, err := conn.AppsV1().Deployments(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.AppsV1().Deployments(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update deployment: %s", err)
 	}
 	log.Printf("[INFO] Submitted updated deployment: %#v", out)
 
-	if d.Get("wait_for_rollout").(bool) {
-		log.Printf("[INFO] Waiting for deployment %s/%s to rollout", out.ObjectMeta.Namespace, out.ObjectMeta.Name)
-		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
-			waitForDeploymentReplicasFunc(ctx, conn, out.GetNamespace(), out.GetName()))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-
 	return resourceKubernetesDeploymentRead(ctx, d, meta)
 }
 
+func expandDeployment(d *schema.ResourceData) (*appsv1.Deployment, error) {
+	spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.Deployment{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesDeploymentExists(ctx, d, meta)
 	if err != nil {
@@ -339,7 +335,7 @@ func resourceKubernetesDeploymentRead(ctx context.Context, d *schema.ResourceDat
 	}
 	log.Printf("[INFO] Received deployment: %#v", deployment)
 
-	err = d.Set("metadata", flattenMetadata(deployment.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(deployment.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -430,45 +426,3 @@ func GetDeploymentCondition(status appsv1.DeploymentStatus, condType appsv1.Depl
 	}
 	return nil
 }
-
-func waitForDeploymentReplicasFunc(ctx context.Context, conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
-	return func() *resource.RetryError {
-		// Query the deployment to get a status update.
-		dply, err := conn.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		var specReplicas int32 = 1 // default, according to API docs
-		if dply.Spec.Replicas != nil {
-			specReplicas = *dply.Spec.Replicas
-		}
-
-		if dply.Generation <= dply.Status.ObservedGeneration {
-			cond := GetDeploymentCondition(dply.Status, appsv1.DeploymentProgressing)
-			if cond != nil && cond.Reason == TimedOutReason {
-				err := fmt.Errorf("Deployment exceeded its progress deadline")
-				return resource.NonRetryableError(err)
-			}
-
-			if dply.Status.UpdatedReplicas < specReplicas {
-				return resource.RetryableError(fmt.Errorf("Waiting for rollout to finish: %d out of %d new replicas have been updated...", dply.Status.UpdatedReplicas, specReplicas))
-			}
-
-			if dply.Status.Replicas > dply.Status.UpdatedReplicas {
-				return resource.RetryableError(fmt.Errorf("Waiting for rollout to finish: %d old replicas are pending termination...", dply.Status.Replicas-dply.Status.UpdatedReplicas))
-			}
-
-			if dply.Status.Replicas > dply.Status.ReadyReplicas {
-				return resource.RetryableError(fmt.Errorf("Waiting for rollout to finish: %d replicas wanted; %d replicas Ready", dply.Status.Replicas, dply.Status.ReadyReplicas))
-			}
-
-			if dply.Status.AvailableReplicas < dply.Status.UpdatedReplicas {
-				return resource.RetryableError(fmt.Errorf("Waiting for rollout to finish: %d of %d updated replicas are available...", dply.Status.AvailableReplicas, dply.Status.UpdatedReplicas))
-			}
-		} else if dply.Status.ObservedGeneration == 0 {
-			return resource.RetryableError(fmt.Errorf("Waiting for rollout to start"))
-		}
-		return nil
-	}
-}
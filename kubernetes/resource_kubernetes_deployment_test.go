@@ -82,6 +82,31 @@ func TestAccKubernetesDeployment_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDeployment_wait(t *testing.T) {
+	var conf appsv1.Deployment
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := nginxImageVersion
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     "kubernetes_deployment.test",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentConfig_wait(name, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists("kubernetes_deployment.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "wait.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "wait.0.rollout", "true"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "wait.0.condition.0.type", "Available"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "wait.0.fields.status.readyReplicas", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesDeployment_initContainerForceNew(t *testing.T) {
 	var conf1, conf2 appsv1.Deployment
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -2886,3 +2911,43 @@ func testAccKubernetesDeploymentConfig_afterUpdate(name, nginxImage, busyboxImag
 }
 `, name, nginxImage, busyboxImage)
 }
+
+func testAccKubernetesDeploymentConfig_wait(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_deployment" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    replicas = 2
+    selector {
+      match_labels = {
+        TestLabelOne = "one"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image = "%s"
+          name  = "tf-acc-test"
+        }
+      }
+    }
+  }
+  wait_for_rollout = false
+  wait {
+    rollout = true
+    condition {
+      type = "Available"
+    }
+    fields = {
+      "status.readyReplicas" = "2"
+    }
+  }
+}
+`, name, imageName)
+}
@@ -10,7 +10,8 @@ import (
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesEndpoints() *schema.Resource {
@@ -42,13 +43,18 @@ func resourceKubernetesEndpointsCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	ep := api.Endpoints{
-		ObjectMeta: metadata,
-		Subsets:    expandEndpointsSubsets(d.Get("subset").(*schema.Set)),
-	}
+	ep := expandEndpoints(d)
 	log.Printf("[INFO] Creating new endpoints: %#v", ep)
-	out, err := conn.CoreV1().Endpoints(metadata.Namespace).Create(ctx, &ep, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, ep)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.Endpoints
+	if apply {
+		out, err = conn.CoreV1().Endpoints(ep.Namespace).Patch(ctx, ep.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.CoreV1().Endpoints(ep.Namespace).Create(ctx, ep, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create endpoints because: %s", err)
 	}
@@ -58,6 +64,13 @@ func resourceKubernetesEndpointsCreate(ctx context.Context, d *schema.ResourceDa
 	return resourceKubernetesEndpointsRead(ctx, d, meta)
 }
 
+func expandEndpoints(d *schema.ResourceData) *api.Endpoints {
+	return &api.Endpoints{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Subsets:    expandEndpointsSubsets(d.Get("subset").(*schema.Set)),
+	}
+}
+
 func resourceKubernetesEndpointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesEndpointsExists(ctx, d, meta)
 	if err != nil {
@@ -84,7 +97,7 @@ func resourceKubernetesEndpointsRead(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("Failed to read endpoint because: %s", err)
 	}
 	log.Printf("[INFO] Received endpoints: %#v", ep)
-	err = d.Set("metadata", flattenMetadata(ep.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(ep.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.Errorf("Failed to read endpoints because: %s", err)
 	}
@@ -118,12 +131,14 @@ func resourceKubernetesEndpointsUpdate(ctx context.Context, d *schema.ResourceDa
 			Value: subsets,
 		})
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandEndpoints(d), nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating endpoints %q: %v", name, string(data))
-	out, err := conn.CoreV1().Endpoints(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.CoreV1().Endpoints(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update endpoints: %s", err)
 	}
@@ -6,13 +6,13 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	networking "k8s.io/api/networking/v1beta1"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"k8s.io/api/extensions/v1beta1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesIngress() *schema.Resource {
@@ -25,21 +25,26 @@ func resourceKubernetesIngress() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
 			{
 				Type:    resourceKubernetesIngressV0().CoreConfigSchema().ImpliedType(),
 				Upgrade: resourceKubernetesIngressStateUpgradeV0,
 				Version: 0,
 			},
+			{
+				Type:    resourceKubernetesIngressV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceKubernetesIngressStateUpgradeV1,
+				Version: 1,
+			},
 		},
-		Schema: resourceKubernetesIngressSchemaV1(),
+		Schema: resourceKubernetesIngressSchemaV2(),
 	}
 }
 
-func resourceKubernetesIngressSchemaV1() map[string]*schema.Schema {
+func resourceKubernetesIngressSchemaV2() map[string]*schema.Schema {
 	docHTTPIngressPath := networking.HTTPIngressPath{}.SwaggerDoc()
-	docHTTPIngressRuleValue := networking.HTTPIngressPath{}.SwaggerDoc()
+	docHTTPIngressRuleValue := networking.HTTPIngressRuleValue{}.SwaggerDoc()
 	docIngress := networking.Ingress{}.SwaggerDoc()
 	docIngressTLS := networking.IngressTLS{}.SwaggerDoc()
 	docIngressRule := networking.IngressRule{}.SwaggerDoc()
@@ -59,7 +64,7 @@ func resourceKubernetesIngressSchemaV1() map[string]*schema.Schema {
 						Description: docIngressSpec["ingressClassName"],
 						Optional:    true,
 					},
-					"backend": backendSpecFields(defaultBackendDescription),
+					"default_backend": backendSpecFieldsV1(defaultBackendDescription),
 					"rule": {
 						Type:        schema.TypeList,
 						Description: docIngress["rules"],
@@ -89,7 +94,14 @@ func resourceKubernetesIngressSchemaV1() map[string]*schema.Schema {
 															Description: docHTTPIngressPath["path"],
 															Optional:    true,
 														},
-														"backend": backendSpecFields(ruleBackedDescription),
+														"path_type": {
+															Type:         schema.TypeString,
+															Description:  docHTTPIngressPath["pathType"],
+															Optional:     true,
+															Default:      string(networking.PathTypeImplementationSpecific),
+															ValidateFunc: validation.StringInSlice([]string{string(networking.PathTypeImplementationSpecific), string(networking.PathTypeExact), string(networking.PathTypePrefix)}, false),
+														},
+														"backend": backendSpecFieldsV1(ruleBackedDescription),
 													},
 												},
 											},
@@ -163,56 +175,20 @@ func resourceKubernetesIngressSchemaV1() map[string]*schema.Schema {
 }
 
 func resourceKubernetesIngressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	ing := &v1beta1.Ingress{
+	ing := &networking.Ingress{
 		Spec: expandIngressSpec(d.Get("spec").([]interface{})),
 	}
 	ing.ObjectMeta = metadata
 	log.Printf("[INFO] Creating new ingress: %#v", ing)
-	out, err := conn.ExtensionsV1beta1().Ingresses(metadata.Namespace).Create(ctx, ing, metav1.CreateOptions{})
+	out, err := createIngress(ctx, meta.(KubeClientsets), ing)
 	if err != nil {
 		return diag.Errorf("Failed to create Ingress '%s' because: %s", buildId(ing.ObjectMeta), err)
 	}
 	log.Printf("[INFO] Submitted new ingress: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
-	if !d.Get("wait_for_load_balancer").(bool) {
-		return resourceKubernetesIngressRead(ctx, d, meta)
-	}
-
-	log.Printf("[INFO] Waiting for load balancer to become ready: %#v", out)
-	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		res, err := conn.ExtensionsV1beta1().Ingresses(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
-		if err != nil {
-			// NOTE it is possible in some HA apiserver setups that are eventually consistent
-			// that we could get a 404 when doing a Get immediately after a Create
-			if errors.IsNotFound(err) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		if len(res.Status.LoadBalancer.Ingress) > 0 {
-			diagnostics := resourceKubernetesIngressRead(ctx, d, meta)
-			if diagnostics.HasError() {
-				errmsg := diagnostics[0].Summary
-				return resource.NonRetryableError(fmt.Errorf("Error reading ingress: %v", errmsg))
-			}
-			return nil
-		}
-
-		log.Printf("[INFO] Load Balancer not ready yet...")
-		return resource.RetryableError(fmt.Errorf("Load Balancer is not ready yet"))
-	})
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	return diag.Diagnostics{}
+	return resourceKubernetesIngressRead(ctx, d, meta)
 }
 
 func resourceKubernetesIngressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -224,24 +200,19 @@ func resourceKubernetesIngressRead(ctx context.Context, d *schema.ResourceData,
 		d.SetId("")
 		return diag.Diagnostics{}
 	}
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Reading ingress %s", name)
-	ing, err := conn.ExtensionsV1beta1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	ing, err := getIngress(ctx, meta.(KubeClientsets), namespace, name)
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
-		return diag.Errorf("Failed to read Ingress '%s' because: %s", buildId(ing.ObjectMeta), err)
+		return diag.Errorf("Failed to read Ingress '%s' because: %s", d.Id(), err)
 	}
 	log.Printf("[INFO] Received ingress: %#v", ing)
-	err = d.Set("metadata", flattenMetadata(ing.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(ing.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -255,7 +226,7 @@ func resourceKubernetesIngressRead(ctx context.Context, d *schema.ResourceData,
 
 	err = d.Set("status", []interface{}{
 		map[string][]interface{}{
-			"load_balancer": flattenLoadBalancerStatus(ing.Status.LoadBalancer),
+			"load_balancer": flattenIngressLoadBalancerStatus(ing.Status.LoadBalancer),
 		},
 	})
 	if err != nil {
@@ -266,16 +237,6 @@ func resourceKubernetesIngressRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceKubernetesIngressUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	namespace, _, err := idParts(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	metadata := expandMetadata(d.Get("metadata").([]interface{}))
 	spec := expandIngressSpec(d.Get("spec").([]interface{}))
 
@@ -283,12 +244,12 @@ func resourceKubernetesIngressUpdate(ctx context.Context, d *schema.ResourceData
 		metadata.Namespace = "default"
 	}
 
-	ingress := &v1beta1.Ingress{
+	ingress := &networking.Ingress{
 		ObjectMeta: metadata,
 		Spec:       spec,
 	}
 
-	out, err := conn.ExtensionsV1beta1().Ingresses(namespace).Update(ctx, ingress, metav1.UpdateOptions{})
+	out, err := updateIngress(ctx, meta.(KubeClientsets), ingress)
 	if err != nil {
 		return diag.Errorf("Failed to update Ingress %s because: %s", buildId(ingress.ObjectMeta), err)
 	}
@@ -298,24 +259,19 @@ func resourceKubernetesIngressUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesIngressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting ingress: %#v", name)
-	err = conn.ExtensionsV1beta1().Ingresses(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = deleteIngress(ctx, meta.(KubeClientsets), namespace, name)
 	if err != nil {
 		return diag.Errorf("Failed to delete Ingress %s because: %s", d.Id(), err)
 	}
 
 	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := conn.ExtensionsV1beta1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+		_, err := getIngress(ctx, meta.(KubeClientsets), namespace, name)
 		if err != nil {
 			if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 				return nil
@@ -337,18 +293,13 @@ func resourceKubernetesIngressDelete(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesIngressExists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return false, err
-	}
-
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return false, err
 	}
 
 	log.Printf("[INFO] Checking ingress %s", name)
-	_, err = conn.ExtensionsV1beta1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	_, err = getIngress(ctx, meta.(KubeClientsets), namespace, name)
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return false, nil
@@ -357,3 +308,116 @@ func resourceKubernetesIngressExists(ctx context.Context, d *schema.ResourceData
 	}
 	return true, err
 }
+
+// The helpers below serve ingresses from networking.k8s.io/v1, converting them
+// from and to networking.k8s.io/v1beta1 on clusters which don't serve v1 yet.
+
+func createIngress(ctx context.Context, k KubeClientsets, ing *networking.Ingress) (*networking.Ingress, error) {
+	conn, err := k.MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	useV1beta1, err := useNetworkingV1beta1Ingress(k)
+	if err != nil {
+		return nil, err
+	}
+	if useV1beta1 {
+		in := convertIngressToV1beta1(ing)
+		data, apply, err := createPatch(ctx, k, in)
+		if err != nil {
+			return nil, err
+		}
+		if apply {
+			out, err := conn.NetworkingV1beta1().Ingresses(ing.Namespace).Patch(ctx, in.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return convertIngressFromV1beta1(out), nil
+		}
+		out, err := conn.NetworkingV1beta1().Ingresses(ing.Namespace).Create(ctx, in, metav1.CreateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return convertIngressFromV1beta1(out), nil
+	}
+	data, apply, err := createPatch(ctx, k, ing)
+	if err != nil {
+		return nil, err
+	}
+	if apply {
+		return conn.NetworkingV1().Ingresses(ing.Namespace).Patch(ctx, ing.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	}
+	return conn.NetworkingV1().Ingresses(ing.Namespace).Create(ctx, ing, metav1.CreateOptions{})
+}
+
+func getIngress(ctx context.Context, k KubeClientsets, namespace, name string) (*networking.Ingress, error) {
+	conn, err := k.MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	useV1beta1, err := useNetworkingV1beta1Ingress(k)
+	if err != nil {
+		return nil, err
+	}
+	if useV1beta1 {
+		out, err := conn.NetworkingV1beta1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return convertIngressFromV1beta1(out), nil
+	}
+	return conn.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+func updateIngress(ctx context.Context, k KubeClientsets, ing *networking.Ingress) (*networking.Ingress, error) {
+	conn, err := k.MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	useV1beta1, err := useNetworkingV1beta1Ingress(k)
+	if err != nil {
+		return nil, err
+	}
+	if useV1beta1 {
+		in := convertIngressToV1beta1(ing)
+		if k.ServerSideApply() {
+			data, err := marshalApplyPatch(ctx, in)
+			if err != nil {
+				return nil, err
+			}
+			out, err := conn.NetworkingV1beta1().Ingresses(ing.Namespace).Patch(ctx, ing.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return convertIngressFromV1beta1(out), nil
+		}
+		out, err := conn.NetworkingV1beta1().Ingresses(ing.Namespace).Update(ctx, in, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return convertIngressFromV1beta1(out), nil
+	}
+	if k.ServerSideApply() {
+		data, err := marshalApplyPatch(ctx, ing)
+		if err != nil {
+			return nil, err
+		}
+		return conn.NetworkingV1().Ingresses(ing.Namespace).Patch(ctx, ing.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	}
+	return conn.NetworkingV1().Ingresses(ing.Namespace).Update(ctx, ing, metav1.UpdateOptions{})
+}
+
+func deleteIngress(ctx context.Context, k KubeClientsets, namespace, name string) error {
+	conn, err := k.MainClientset()
+	if err != nil {
+		return err
+	}
+	useV1beta1, err := useNetworkingV1beta1Ingress(k)
+	if err != nil {
+		return err
+	}
+	if useV1beta1 {
+		return conn.NetworkingV1beta1().Ingresses(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	}
+	return conn.NetworkingV1().Ingresses(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
@@ -0,0 +1,290 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesIngressClass() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesIngressClassCreate,
+		ReadContext:   resourceKubernetesIngressClassRead,
+		UpdateContext: resourceKubernetesIngressClassUpdate,
+		DeleteContext: resourceKubernetesIngressClassDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resourceKubernetesIngressClassSchema(),
+	}
+}
+
+func resourceKubernetesIngressClassSchema() map[string]*schema.Schema {
+	docIngressClass := networking.IngressClass{}.SwaggerDoc()
+	docIngressClassSpec := networking.IngressClassSpec{}.SwaggerDoc()
+	docParameters := networking.IngressClassParametersReference{}.SwaggerDoc()
+
+	return map[string]*schema.Schema{
+		"metadata": metadataSchema("ingress class", true),
+		"spec": {
+			Type:        schema.TypeList,
+			Description: docIngressClass["spec"],
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"controller": {
+						Type:        schema.TypeString,
+						Description: docIngressClassSpec["controller"],
+						Optional:    true,
+						ForceNew:    true,
+					},
+					"parameters": {
+						Type:        schema.TypeList,
+						Description: docIngressClassSpec["parameters"],
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"api_group": {
+									Type:        schema.TypeString,
+									Description: docParameters["apiGroup"],
+									Optional:    true,
+								},
+								"kind": {
+									Type:         schema.TypeString,
+									Description:  docParameters["kind"],
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+								"name": {
+									Type:         schema.TypeString,
+									Description:  docParameters["name"],
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+								"scope": {
+									Type:        schema.TypeString,
+									Description: docParameters["scope"],
+									Optional:    true,
+									Computed:    true,
+									ValidateFunc: validation.StringInSlice([]string{
+										networking.IngressClassParametersReferenceScopeCluster,
+										networking.IngressClassParametersReferenceScopeNamespace,
+									}, false),
+								},
+								"namespace": {
+									Type:        schema.TypeString,
+									Description: docParameters["namespace"],
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"default": {
+			Type:        schema.TypeBool,
+			Description: "Marks this ingress class as the default one, assigned to ingresses which don't specify an ingress class. Sets the `" + ingressClassDefaultAnnotation + "` annotation.",
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
+
+func resourceKubernetesIngressClassCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ingressClass := expandIngressClass(d)
+
+	log.Printf("[INFO] Creating new ingress class: %#v", ingressClass)
+	data, apply, err := createPatch(ctx, meta, ingressClass)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *networking.IngressClass
+	if apply {
+		out, err = conn.NetworkingV1().IngressClasses().Patch(ctx, ingressClass.Name, pkgApi.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.NetworkingV1().IngressClasses().Create(ctx, ingressClass, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return diag.Errorf("Failed to create ingress class '%s' because: %s", ingressClass.Name, err)
+	}
+	log.Printf("[INFO] Submitted new ingress class: %#v", out)
+	d.SetId(out.ObjectMeta.Name)
+
+	return resourceKubernetesIngressClassRead(ctx, d, meta)
+}
+
+func expandIngressClass(d *schema.ResourceData) *networking.IngressClass {
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	if d.Get("default").(bool) {
+		if metadata.Annotations == nil {
+			metadata.Annotations = map[string]string{}
+		}
+		metadata.Annotations[ingressClassDefaultAnnotation] = "true"
+	}
+	return &networking.IngressClass{
+		ObjectMeta: metadata,
+		Spec:       expandIngressClassSpec(d.Get("spec").([]interface{})),
+	}
+}
+
+func resourceKubernetesIngressClassRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceKubernetesIngressClassExists(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Reading ingress class %s", name)
+	ingressClass, err := conn.NetworkingV1().IngressClasses().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read ingress class '%s' because: %s", name, err)
+	}
+	log.Printf("[INFO] Received ingress class: %#v", ingressClass)
+
+	err = d.Set("metadata", flattenResourceMetadata(ingressClass.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("spec", flattenIngressClassSpec(ingressClass.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("default", flattenIngressClassDefault(*ingressClass))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesIngressClassUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec.0.parameters") {
+		ops = append(ops, &AddOperation{
+			Path:  "/spec/parameters",
+			Value: expandIngressClassParameters(d.Get("spec.0.parameters").([]interface{})),
+		})
+	}
+
+	serverSideApply := meta.(KubeClientsets).ServerSideApply()
+	if serverSideApply || len(ops) > 0 {
+		patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+			return expandIngressClass(d), nil
+		})
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations: %s", err)
+		}
+		log.Printf("[INFO] Updating ingress class %q: %v", name, string(data))
+		out, err := conn.NetworkingV1().IngressClasses().Patch(ctx, name, patchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to update ingress class: %s", err)
+		}
+		log.Printf("[INFO] Submitted updated ingress class: %#v", out)
+	}
+
+	// Annotations patched from an empty map are replaced as a whole, so the default
+	// annotation is set again through a merge patch whenever the annotations change.
+	// An apply patch already carries it along with the other annotations.
+	if !serverSideApply && (d.HasChange("default") || (d.Get("default").(bool) && d.HasChange("metadata.0.annotations"))) {
+		var value interface{}
+		if d.Get("default").(bool) {
+			value = "true"
+		}
+		data, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					ingressClassDefaultAnnotation: value,
+				},
+			},
+		})
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations: %s", err)
+		}
+		log.Printf("[INFO] Updating default annotation of ingress class %q: %v", name, string(data))
+		_, err = conn.NetworkingV1().IngressClasses().Patch(ctx, name, pkgApi.MergePatchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to update ingress class: %s", err)
+		}
+	}
+
+	return resourceKubernetesIngressClassRead(ctx, d, meta)
+}
+
+func resourceKubernetesIngressClassDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Deleting ingress class: %#v", name)
+	err = conn.NetworkingV1().IngressClasses().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete ingress class %s because: %s", name, err)
+	}
+
+	log.Printf("[INFO] Ingress class %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesIngressClassExists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return false, err
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Checking ingress class %s", name)
+	_, err = conn.NetworkingV1().IngressClasses().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
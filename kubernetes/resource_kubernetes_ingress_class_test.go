@@ -0,0 +1,169 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesIngressClass_basic(t *testing.T) {
+	var conf networking.IngressClass
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_ingress_class.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesIngressClassDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesIngressClassConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressClassExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.TestAnnotationOne", "one"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "spec.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.controller", "example.com/ingress-controller"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parameters.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesIngressClassConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressClassExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.controller", "example.com/ingress-controller"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parameters.0.api_group", "k8s.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parameters.0.kind", "IngressParameters"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parameters.0.name", "external-lb"),
+					resource.TestCheckResourceAttr(resourceName, "default", "true"),
+					testAccCheckKubernetesIngressClassDefault(&conf, true),
+				),
+			},
+			{
+				Config: testAccKubernetesIngressClassConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressClassExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "default", "false"),
+					testAccCheckKubernetesIngressClassDefault(&conf, false),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesIngressClassDefault(obj *networking.IngressClass, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if flattenIngressClassDefault(*obj) != expected {
+			return fmt.Errorf("Expected default annotation to be %t, got annotations %#v", expected, obj.Annotations)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesIngressClassDestroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_ingress_class" {
+			continue
+		}
+
+		name := rs.Primary.ID
+
+		resp, err := conn.NetworkingV1().IngressClasses().Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			if resp.Name == name {
+				return fmt.Errorf("Ingress class still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesIngressClassExists(n string, obj *networking.IngressClass) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		name := rs.Primary.ID
+
+		out, err := conn.NetworkingV1().IngressClasses().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesIngressClassConfig_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_ingress_class" "test" {
+  metadata {
+    annotations = {
+      TestAnnotationOne = "one"
+    }
+
+    name = "%s"
+  }
+
+  spec {
+    controller = "example.com/ingress-controller"
+  }
+}
+`, name)
+}
+
+func testAccKubernetesIngressClassConfig_modified(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_ingress_class" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    controller = "example.com/ingress-controller"
+    parameters {
+      api_group = "k8s.example.com"
+      kind      = "IngressParameters"
+      name      = "external-lb"
+    }
+  }
+
+  default = true
+}
+`, name)
+}
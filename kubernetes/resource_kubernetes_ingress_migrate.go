@@ -2,9 +2,11 @@ package kubernetes
 
 import (
 	"context"
+	"log"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	networking "k8s.io/api/networking/v1beta1"
-	"log"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // resourceKubernetesIngressV0 is a copy of the Kubernetes Ingress schema (before migration).
@@ -139,3 +141,204 @@ func resourceKubernetesIngressStateUpgradeV0(ctx context.Context, rawState map[s
 	delete(rawState, "load_balancer_ingress")
 	return rawState, nil
 }
+
+// resourceKubernetesIngressV1 is a copy of the extensions/v1beta1 Kubernetes Ingress schema (before migration to networking.k8s.io/v1).
+func resourceKubernetesIngressV1() *schema.Resource {
+	docHTTPIngressPath := networking.HTTPIngressPath{}.SwaggerDoc()
+	docHTTPIngressRuleValue := networking.HTTPIngressPath{}.SwaggerDoc()
+	docIngress := networking.Ingress{}.SwaggerDoc()
+	docIngressTLS := networking.IngressTLS{}.SwaggerDoc()
+	docIngressRule := networking.IngressRule{}.SwaggerDoc()
+	docIngressSpec := networking.IngressSpec{}.SwaggerDoc()
+
+	return &schema.Resource{Schema: map[string]*schema.Schema{
+		"metadata": namespacedMetadataSchema("ingress", true),
+		"spec": {
+			Type:        schema.TypeList,
+			Description: docIngress["spec"],
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ingress_class_name": {
+						Type:        schema.TypeString,
+						Description: docIngressSpec["ingressClassName"],
+						Optional:    true,
+					},
+					"backend": backendSpecFields(defaultBackendDescription),
+					"rule": {
+						Type:        schema.TypeList,
+						Description: docIngress["rules"],
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"host": {
+									Type:        schema.TypeString,
+									Description: docIngressRule["host"],
+									Optional:    true,
+								},
+								"http": {
+									Type:        schema.TypeList,
+									Required:    true,
+									MaxItems:    1,
+									Description: "http is a list of http selectors pointing to backends. In the example: http:///? -> backend where where parts of the url correspond to RFC 3986, this resource will be used to match against everything after the last '/' and before the first '?' or '#'.",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"path": {
+												Type:        schema.TypeList,
+												Required:    true,
+												Description: docHTTPIngressRuleValue["paths"],
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"path": {
+															Type:        schema.TypeString,
+															Description: docHTTPIngressPath["path"],
+															Optional:    true,
+														},
+														"backend": backendSpecFields(ruleBackedDescription),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"tls": {
+						Type:        schema.TypeList,
+						Description: docIngressSpec["tls"],
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"hosts": {
+									Type:        schema.TypeList,
+									Description: docIngressTLS["hosts"],
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+								},
+								"secret_name": {
+									Type:        schema.TypeString,
+									Description: docIngressTLS["secretName"],
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"status": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"load_balancer": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"ingress": {
+									Type:     schema.TypeList,
+									Computed: true,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"ip": {
+												Type:     schema.TypeString,
+												Computed: true,
+											},
+											"hostname": {
+												Type:     schema.TypeString,
+												Computed: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"wait_for_load_balancer": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Terraform will wait for the load balancer to have at least 1 endpoint before considering the resource created.",
+		},
+	}}
+}
+
+// resourceKubernetesIngressStateUpgradeV1 moves the state to the networking.k8s.io/v1 schema:
+// `backend` becomes `default_backend`, backends reference a `service` block
+// and paths get the default `path_type`.
+func resourceKubernetesIngressStateUpgradeV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	log.Println("[INFO] Found Kubernetes Ingress state v1; upgrading state to v2")
+	specs, _ := rawState["spec"].([]interface{})
+	for _, s := range specs {
+		spec, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		spec["default_backend"] = upgradeIngressBackendStateV1(spec["backend"])
+		delete(spec, "backend")
+
+		rules, _ := spec["rule"].([]interface{})
+		for _, r := range rules {
+			rule, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			https, _ := rule["http"].([]interface{})
+			for _, h := range https {
+				http, ok := h.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				paths, _ := http["path"].([]interface{})
+				for _, p := range paths {
+					path, ok := p.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					path["backend"] = upgradeIngressBackendStateV1(path["backend"])
+					path["path_type"] = "ImplementationSpecific"
+				}
+			}
+		}
+	}
+	return rawState, nil
+}
+
+func upgradeIngressBackendStateV1(v interface{}) []interface{} {
+	backends, _ := v.([]interface{})
+	out := make([]interface{}, 0, len(backends))
+	for _, b := range backends {
+		backend, ok := b.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		port := map[string]interface{}{
+			"name":   "",
+			"number": 0,
+		}
+		if p, ok := backend["service_port"].(string); ok && p != "" {
+			servicePort := intstr.Parse(p)
+			if servicePort.Type == intstr.Int {
+				port["number"] = servicePort.IntValue()
+			} else {
+				port["name"] = servicePort.StrVal
+			}
+		}
+		name, _ := backend["service_name"].(string)
+		out = append(out, map[string]interface{}{
+			"service": []interface{}{
+				map[string]interface{}{
+					"name": name,
+					"port": []interface{}{port},
+				},
+			},
+			"resource": []interface{}{},
+		})
+	}
+	return out
+}
@@ -8,8 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	api "k8s.io/api/extensions/v1beta1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/api/networking/v1"
 )
 
 func TestAccKubernetesIngress_basic(t *testing.T) {
@@ -32,16 +31,17 @@ func TestAccKubernetesIngress_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("kubernetes_ingress.test", "metadata.0.uid"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.#", "1"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.ingress_class_name", "ingress-class"),
-					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.backend.#", "1"),
-					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.backend.0.service_name", "app1"),
-					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.backend.0.service_port", "443"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.default_backend.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.default_backend.0.service.0.name", "app1"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.default_backend.0.service.0.port.0.number", "443"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.#", "1"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.host", "server.domain.com"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.#", "1"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.path", "/.*"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.path_type", "ImplementationSpecific"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.#", "1"),
-					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service_name", "app2"),
-					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service_port", "80"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service.0.name", "app2"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service.0.port.0.number", "80"),
 				),
 			},
 			{
@@ -54,9 +54,9 @@ func TestAccKubernetesIngress_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("kubernetes_ingress.test", "metadata.0.uid"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.#", "1"),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.ingress_class_name", "other-ingress-class"),
-					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.backend.#", "1"),
-					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.backend.0.service_name", "svc"),
-					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.backend.0.service_port", "8443"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.default_backend.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.default_backend.0.service.0.name", "svc"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.default_backend.0.service.0.port.0.number", "8443"),
 				),
 			},
 		},
@@ -169,7 +169,7 @@ func TestAccKubernetesIngress_WaitForLoadBalancerGoogleCloud(t *testing.T) {
 	})
 }
 
-func TestAccKubernetesIngress_stateUpgradeV0_loadBalancerIngress(t *testing.T) {
+func TestAccKubernetesIngress_stateUpgradeV0toV2(t *testing.T) {
 	var conf1, conf2 api.Ingress
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
@@ -186,7 +186,7 @@ func TestAccKubernetesIngress_stateUpgradeV0_loadBalancerIngress(t *testing.T) {
 				),
 			},
 			{
-				Config: requiredProviders() + testAccKubernetesIngressConfig_stateUpgradev0("kubernetes-local", name),
+				Config: requiredProviders() + testAccKubernetesIngressConfig_stateUpgradev2("kubernetes-local", name),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKubernetesIngressExists("kubernetes_ingress.test", &conf2),
 					testAccCheckKubernetesIngressForceNew(&conf1, &conf2, false),
@@ -212,11 +212,6 @@ func testAccCheckKubernetesIngressForceNew(old, new *api.Ingress, wantNew bool)
 }
 
 func testAccCheckKubernetesIngressDestroy(s *terraform.State) error {
-	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
-
-	if err != nil {
-		return err
-	}
 	ctx := context.TODO()
 
 	for _, rs := range s.RootModule().Resources {
@@ -229,7 +224,7 @@ func testAccCheckKubernetesIngressDestroy(s *terraform.State) error {
 			return err
 		}
 
-		resp, err := conn.ExtensionsV1beta1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+		resp, err := getIngress(ctx, testAccProvider.Meta().(KubeClientsets), namespace, name)
 		if err == nil {
 			if resp.Name == rs.Primary.ID {
 				return fmt.Errorf("Ingress still exists: %s", rs.Primary.ID)
@@ -247,10 +242,6 @@ func testAccCheckKubernetesIngressExists(n string, obj *api.Ingress) resource.Te
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
-		if err != nil {
-			return err
-		}
 		ctx := context.TODO()
 
 		namespace, name, err := idParts(rs.Primary.ID)
@@ -258,7 +249,7 @@ func testAccCheckKubernetesIngressExists(n string, obj *api.Ingress) resource.Te
 			return err
 		}
 
-		out, err := conn.ExtensionsV1beta1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+		out, err := getIngress(ctx, testAccProvider.Meta().(KubeClientsets), namespace, name)
 		if err != nil {
 			return err
 		}
@@ -275,17 +266,25 @@ func testAccKubernetesIngressConfig_basic(name string) string {
   }
   spec {
 	ingress_class_name = "ingress-class"
-    backend {
-      service_name = "app1"
-      service_port = 443
+    default_backend {
+      service {
+        name = "app1"
+        port {
+          number = 443
+        }
+      }
     }
     rule {
       host = "server.domain.com"
       http {
         path {
           backend {
-            service_name = "app2"
-            service_port = 80
+            service {
+              name = "app2"
+              port {
+                number = 80
+              }
+            }
           }
           path = "/.*"
         }
@@ -302,9 +301,13 @@ func testAccKubernetesIngressConfig_modified(name string) string {
   }
   spec {
 	ingress_class_name = "other-ingress-class"
-    backend {
-      service_name = "svc"
-      service_port = 8443
+    default_backend {
+      service {
+        name = "svc"
+        port {
+          number = 8443
+        }
+      }
     }
   }
 }`, name)
@@ -316,9 +319,13 @@ func testAccKubernetesIngressConfig_TLS(name string) string {
     name = "%s"
   }
   spec {
-    backend {
-      service_name = "app1"
-      service_port = 443
+    default_backend {
+      service {
+        name = "app1"
+        port {
+          number = 443
+        }
+      }
     }
     tls {
       hosts       = ["host1"]
@@ -334,9 +341,13 @@ func testAccKubernetesIngressConfig_TLS_modified(name string) string {
     name = "%s"
   }
   spec {
-    backend {
-      service_name = "app1"
-      service_port = 443
+    default_backend {
+      service {
+        name = "app1"
+        port {
+          number = 443
+        }
+      }
     }
     tls {
       hosts       = ["host1", "host2"]
@@ -361,9 +372,13 @@ func testAccKubernetesIngressConfig_internalKey(name string) string {
     }
   }
   spec {
-    backend {
-      service_name = "app1"
-      service_port = 443
+    default_backend {
+      service {
+        name = "app1"
+        port {
+          number = 443
+        }
+      }
     }
     tls {
       hosts       = ["host1", "host2"]
@@ -386,9 +401,13 @@ func testAccKubernetesIngressConfig_internalKey_removed(name string) string {
     }
   }
   spec {
-    backend {
-      service_name = "app1"
-      service_port = 443
+    default_backend {
+      service {
+        name = "app1"
+        port {
+          number = 443
+        }
+      }
     }
     tls {
       hosts       = ["host1", "host2"]
@@ -455,9 +474,13 @@ resource "kubernetes_ingress" "test" {
     name = %q
   }
   spec {
-    backend {
-      service_name = %q
-      service_port = 8000
+    default_backend {
+      service {
+        name = %q
+        port {
+          number = 8000
+        }
+      }
     }
   }
   wait_for_load_balancer = true
@@ -507,3 +530,52 @@ resource "kubernetes_ingress" "test" {
 }
 `, provider, name, provider, name)
 }
+
+func testAccKubernetesIngressConfig_stateUpgradev2(provider, name string) string {
+	return fmt.Sprintf(`resource "kubernetes_service" "test" {
+  provider = "%s"
+  metadata {
+    name = "%s"
+  }
+  spec {
+    port {
+      port = 80
+      target_port = 80
+      protocol = "TCP"
+    }
+    type = "NodePort"
+  }
+}
+
+resource "kubernetes_ingress" "test" {
+  provider = "%s"
+  wait_for_load_balancer = false
+  metadata {
+    name = "%s"
+    annotations = {
+      "kubernetes.io/ingress.class" = "alb"
+      "alb.ingress.kubernetes.io/scheme" = "internet-facing"
+      "alb.ingress.kubernetes.io/target-type" = "ip"
+    }
+  }
+  spec {
+    rule {
+      http {
+        path {
+          path = "/*"
+          path_type = "ImplementationSpecific"
+          backend {
+            service {
+              name = kubernetes_service.test.metadata.0.name
+              port {
+                number = 80
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, provider, name, provider, name)
+}
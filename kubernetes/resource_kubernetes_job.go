@@ -11,11 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	batchv1 "k8s.io/api/batch/v1"
-	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesJob() *schema.Resource {
@@ -71,20 +70,23 @@ func resourceKubernetesJobCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandJobSpec(d.Get("spec").([]interface{}))
+	job, err := expandJob(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	job := batchv1.Job{
-		ObjectMeta: metadata,
-		Spec:       spec,
-	}
-
 	log.Printf("[INFO] Creating new Job: %#v", job)
 
-	out, err := conn.BatchV1().Jobs(metadata.Namespace).Create(ctx, &job, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, job)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *batchv1.Job
+	if apply {
+		out, err = conn.BatchV1().Jobs(job.Namespace).Patch(ctx, job.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.BatchV1().Jobs(job.Namespace).Create(ctx, job, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create Job! API error: %s", err)
 	}
@@ -92,19 +94,6 @@ func resourceKubernetesJobCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(buildId(out.ObjectMeta))
 
-	namespace, name, err := idParts(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	if d.Get("wait_for_completion").(bool) {
-		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
-			retryUntilJobIsFinished(ctx, conn, namespace, name))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		return diag.Diagnostics{}
-	}
-
 	return resourceKubernetesJobRead(ctx, d, meta)
 }
 
@@ -129,14 +118,16 @@ func resourceKubernetesJobUpdate(ctx context.Context, d *schema.ResourceData, me
 		ops = append(ops, specOps...)
 	}
 
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandJob(d)
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 
 	log.Printf("[INFO] Updating job %s: %#v", d.Id(), ops)
 
-	out, err := conn.BatchV1().Jobs(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.BatchV1().Jobs(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update Job! API error: %s", err)
 	}
@@ -144,16 +135,20 @@ func resourceKubernetesJobUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(buildId(out.ObjectMeta))
 
-	if d.Get("wait_for_completion").(bool) {
-		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
-			retryUntilJobIsFinished(ctx, conn, namespace, name))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
 	return resourceKubernetesJobRead(ctx, d, meta)
 }
 
+func expandJob(d *schema.ResourceData) (*batchv1.Job, error) {
+	spec, err := expandJobSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &batchv1.Job{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       spec,
+	}, nil
+}
+
 func resourceKubernetesJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesJobExists(ctx, d, meta)
 	if err != nil {
@@ -200,7 +195,7 @@ func resourceKubernetesJobRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(job.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -277,27 +272,3 @@ func resourceKubernetesJobExists(ctx context.Context, d *schema.ResourceData, me
 	}
 	return true, err
 }
-
-// retryUntilJobIsFinished checks if a give job finished its execution and either in Complete or Failed state
-func retryUntilJobIsFinished(ctx context.Context, conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
-	return func() *resource.RetryError {
-		job, err := conn.BatchV1().Jobs(ns).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		for _, c := range job.Status.Conditions {
-			if c.Status == corev1.ConditionTrue {
-				log.Printf("[DEBUG] Current condition of job: %s/%s: %s\n", ns, name, c.Type)
-				switch c.Type {
-				case batchv1.JobComplete:
-					return nil
-				case batchv1.JobFailed:
-					return resource.NonRetryableError(fmt.Errorf("job: %s/%s is in failed state", ns, name))
-				}
-			}
-		}
-
-		return resource.RetryableError(fmt.Errorf("job: %s/%s is not in complete state", ns, name))
-	}
-}
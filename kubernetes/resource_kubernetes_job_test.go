@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -286,6 +287,142 @@ func testAccCheckKubernetesJobExists(n string, obj *api.Job) resource.TestCheckF
 	}
 }
 
+func TestAccKubernetesJob_indexedSuspended(t *testing.T) {
+	var conf1, conf2 api.Job
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := busyboxImageVersion
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.22.0")
+		},
+		IDRefreshName:     "kubernetes_job.test",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesJobDestroy,
+		Steps: []resource.TestStep{
+			{ // The suspended job isn't waited for
+				Config: testAccKubernetesJobConfig_indexedSuspended(name, imageName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesJobExists("kubernetes_job.test", &conf1),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "spec.0.completion_mode", "Indexed"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "spec.0.suspend", "true"),
+				),
+			},
+			{ // The resumed job is waited for
+				Config: testAccKubernetesJobConfig_indexedSuspended(name, imageName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesJobExists("kubernetes_job.test", &conf2),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "spec.0.completion_mode", "Indexed"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "spec.0.suspend", "false"),
+					testAccCheckKubernetesJobCompletedIndexes(&conf2, "0-2"),
+					func(s *terraform.State) error {
+						if conf1.UID != conf2.UID {
+							return fmt.Errorf("Expected the job to be resumed in place, it was recreated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesJob_podFailurePolicy(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := busyboxImageVersion
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.26.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesJobDestroy,
+		Steps: []resource.TestStep{
+			{ // The wait fails naming the rule that failed the job
+				Config:      testAccKubernetesJobConfig_podFailurePolicy(name, imageName),
+				ExpectError: regexp.MustCompile(`pod_failure_policy rule 1: FailJob when the exit code of container hello is In \[42\]`),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesJobCompletedIndexes(obj *api.Job, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if obj.Status.CompletedIndexes != expected {
+			return fmt.Errorf("Expected completed indexes %q, got %q", expected, obj.Status.CompletedIndexes)
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesJobConfig_indexedSuspended(name, imageName string, suspend bool) string {
+	return fmt.Sprintf(`resource "kubernetes_job" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    completion_mode = "Indexed"
+    completions     = 3
+    parallelism     = 3
+    suspend         = %t
+    template {
+      metadata {}
+      spec {
+        container {
+          name    = "hello"
+          image   = "%s"
+          command = ["sh", "-c", "echo index $JOB_COMPLETION_INDEX"]
+        }
+      }
+    }
+  }
+
+  wait_for_completion = true
+}`, name, suspend, imageName)
+}
+
+func testAccKubernetesJobConfig_podFailurePolicy(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_job" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    backoff_limit = 6
+    pod_failure_policy {
+      rule {
+        action = "Ignore"
+        on_pod_conditions {
+          type = "DisruptionTarget"
+        }
+      }
+      rule {
+        action = "FailJob"
+        on_exit_codes {
+          container_name = "hello"
+          operator       = "In"
+          values         = [42]
+        }
+      }
+    }
+    template {
+      metadata {}
+      spec {
+        container {
+          name    = "hello"
+          image   = "%s"
+          command = ["sh", "-c", "exit 42"]
+        }
+        restart_policy = "Never"
+      }
+    }
+  }
+
+  wait_for_completion = true
+}`, name, imageName)
+}
+
 func testAccKubernetesJobConfig_basic(name, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_job" "test" {
   metadata {
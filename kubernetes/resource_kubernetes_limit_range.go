@@ -10,7 +10,8 @@ import (
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesLimitRange() *schema.Resource {
@@ -85,17 +86,21 @@ func resourceKubernetesLimitRangeCreate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), d.IsNewResource())
+	limitRange, err := expandLimitRange(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	limitRange := api.LimitRange{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 	log.Printf("[INFO] Creating new limit range: %#v", limitRange)
-	out, err := conn.CoreV1().LimitRanges(metadata.Namespace).Create(ctx, &limitRange, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, limitRange)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.LimitRange
+	if apply {
+		out, err = conn.CoreV1().LimitRanges(limitRange.Namespace).Patch(ctx, limitRange.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.CoreV1().LimitRanges(limitRange.Namespace).Create(ctx, limitRange, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create limit range: %s", err)
 	}
@@ -105,6 +110,17 @@ func resourceKubernetesLimitRangeCreate(ctx context.Context, d *schema.ResourceD
 	return resourceKubernetesLimitRangeRead(ctx, d, meta)
 }
 
+func expandLimitRange(d *schema.ResourceData) (*api.LimitRange, error) {
+	spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), d.IsNewResource())
+	if err != nil {
+		return nil, err
+	}
+	return &api.LimitRange{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesLimitRangeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesLimitRangeExists(ctx, d, meta)
 	if err != nil {
@@ -131,7 +147,7 @@ func resourceKubernetesLimitRangeRead(ctx context.Context, d *schema.ResourceDat
 	}
 	log.Printf("[INFO] Received limit range: %#v", limitRange)
 
-	err = d.Set("metadata", flattenMetadata(limitRange.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(limitRange.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -165,12 +181,14 @@ func resourceKubernetesLimitRangeUpdate(ctx context.Context, d *schema.ResourceD
 			Value: spec,
 		})
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandLimitRange(d)
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating limit range %q: %v", name, string(data))
-	out, err := conn.CoreV1().LimitRanges(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.CoreV1().LimitRanges(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update limit range: %s", err)
 	}
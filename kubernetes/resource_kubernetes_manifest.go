@@ -0,0 +1,309 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+func resourceKubernetesManifest() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesManifestCreate,
+		ReadContext:   resourceKubernetesManifestRead,
+		UpdateContext: resourceKubernetesManifestUpdate,
+		DeleteContext: resourceKubernetesManifestDelete,
+		CustomizeDiff: resourceKubernetesManifestCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKubernetesManifestImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"manifest": {
+				Type:             schema.TypeString,
+				Description:      "A Kubernetes object in YAML or JSON format. It must set `apiVersion`, `kind` and `metadata.name`. Only the fields set in the manifest are compared against the object in the cluster. Exactly one of `manifest` or `object` must be set.",
+				Optional:         true,
+				ValidateFunc:     validateManifest,
+				DiffSuppressFunc: suppressEquivalentManifest,
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Description: "The unique in time and space value for this object.",
+				Computed:    true,
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Description: "An opaque value that represents the internal version of this object.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// resourceKubernetesManifestCustomizeDiff forces the object to be re-created
+// when any of the fields identifying it changes.
+func resourceKubernetesManifestCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("manifest") {
+		return nil
+	}
+	oldV, newV := d.GetChange("manifest")
+	oldObj, err := expandManifest(oldV.(string))
+	if err != nil {
+		return nil
+	}
+	newObj, err := expandManifest(newV.(string))
+	if err != nil {
+		// The manifest may not be known until apply
+		return nil
+	}
+	if oldObj.GetAPIVersion() != newObj.GetAPIVersion() ||
+		oldObj.GetKind() != newObj.GetKind() ||
+		oldObj.GetNamespace() != newObj.GetNamespace() ||
+		oldObj.GetName() != newObj.GetName() {
+		return d.ForceNew("manifest")
+	}
+	return nil
+}
+
+func resourceKubernetesManifestClient(meta interface{}, gvk apimachineryschema.GroupVersionKind, namespace string) (dynamic.ResourceInterface, string, error) {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return nil, "", err
+	}
+	mapper, err := meta.(KubeClientsets).RESTMapper()
+	if err != nil {
+		return nil, "", err
+	}
+	return manifestResourceClient(dc, mapper, gvk, namespace)
+}
+
+func resourceKubernetesManifestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	obj, err := expandManifest(d.Get("manifest").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, namespace, err := resourceKubernetesManifestClient(meta, obj.GroupVersionKind(), obj.GetNamespace())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	obj.SetNamespace(namespace)
+
+	log.Printf("[INFO] Creating new %s: %#v", obj.GetKind(), obj.Object)
+	data, apply, err := createPatch(ctx, meta, obj)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *unstructured.Unstructured
+	if apply {
+		out, err = client.Patch(ctx, obj.GetName(), pkgApi.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = client.Create(ctx, obj, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return diag.Errorf("Failed to create %s %q: %s", obj.GetKind(), obj.GetName(), err)
+	}
+	log.Printf("[INFO] Submitted new %s: %#v", out.GetKind(), out.Object)
+
+	d.SetId(buildManifestId(out))
+
+	return resourceKubernetesManifestRead(ctx, d, meta)
+}
+
+func resourceKubernetesManifestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	gvk, namespace, name, err := manifestIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, _, err := resourceKubernetesManifestClient(meta, gvk, namespace)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading %s %s", gvk.Kind, name)
+	out, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] %s %q not found, removing from state", gvk.Kind, d.Id())
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received %s: %#v", gvk.Kind, out.Object)
+
+	live, err := normalizeManifestObject(out.Object)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config, err := expandManifest(d.Get("manifest").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	configObj, err := normalizeManifestObject(config.Object)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	manifest, err := flattenManifest(projectManifest(configObj, live).(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("manifest", manifest)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("uid", string(out.GetUID()))
+	d.Set("resource_version", out.GetResourceVersion())
+
+	return nil
+}
+
+func resourceKubernetesManifestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	gvk, namespace, name, err := manifestIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, _, err := resourceKubernetesManifestClient(meta, gvk, namespace)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldV, newV := d.GetChange("manifest")
+	newObj, err := expandManifest(newV.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data []byte
+	patchType := pkgApi.MergePatchType
+	if meta.(KubeClientsets).ServerSideApply() {
+		// The whole manifest is applied, so that the fields removed from it are released
+		newObj.SetNamespace(namespace)
+		patchType = pkgApi.ApplyPatchType
+		data, err = marshalApplyPatch(ctx, newObj)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		oldObj, err := expandManifest(oldV.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		oldData, err := json.Marshal(oldObj.Object)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newData, err := json.Marshal(newObj.Object)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		data, err = jsonpatch.CreateMergePatch(oldData, newData)
+		if err != nil {
+			return diag.Errorf("Failed to build update patch: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updating %s %q: %v", gvk.Kind, name, string(data))
+	out, err := client.Patch(ctx, name, patchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update %s %q: %s", gvk.Kind, name, err)
+	}
+	log.Printf("[INFO] Submitted updated %s: %#v", gvk.Kind, out.Object)
+
+	return resourceKubernetesManifestRead(ctx, d, meta)
+}
+
+func resourceKubernetesManifestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	gvk, namespace, name, err := manifestIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, _, err := resourceKubernetesManifestClient(meta, gvk, namespace)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting %s: %#v", gvk.Kind, name)
+	err = client.Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("%s (%s) still exists", gvk.Kind, d.Id())
+		return resource.RetryableError(e)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] %s %s deleted", gvk.Kind, name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesManifestImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	gvk, namespace, name, err := manifestIdParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	client, namespace, err := resourceKubernetesManifestClient(meta, gvk, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get %s %q: %s", gvk.Kind, name, err)
+	}
+	removeServerManagedFields(out)
+
+	manifest, err := flattenManifest(out.Object)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("manifest", manifest)
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	d.SetId(buildManifestId(obj))
+
+	return []*schema.ResourceData{d}, nil
+}
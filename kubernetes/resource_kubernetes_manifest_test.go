@@ -0,0 +1,165 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesManifest_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_manifest.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesManifestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesManifestConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesManifestExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("v1/ConfigMap/default/%s", name)),
+					resource.TestCheckResourceAttrSet(resourceName, "uid"),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_version"),
+				),
+			},
+			{
+				Config: testAccKubernetesManifestConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesManifestExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("v1/ConfigMap/default/%s", name)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesManifest_importBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_manifest.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesManifestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesManifestConfig_clusterScoped(name),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manifest", "resource_version"},
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesManifestDestroy(s *terraform.State) error {
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_manifest" {
+			continue
+		}
+
+		gvk, namespace, name, err := manifestIdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client, _, err := resourceKubernetesManifestClient(testAccProvider.Meta(), gvk, namespace)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			if resp.GetNamespace() == namespace && resp.GetName() == name {
+				return fmt.Errorf("%s still exists: %s", gvk.Kind, rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesManifestExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		ctx := context.TODO()
+
+		gvk, namespace, name, err := manifestIdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client, _, err := resourceKubernetesManifestClient(testAccProvider.Meta(), gvk, namespace)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.Get(ctx, name, metav1.GetOptions{})
+		return err
+	}
+}
+
+func testAccKubernetesManifestConfig_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_manifest" "test" {
+  manifest = <<EOT
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %s
+  labels:
+    TestLabelOne: one
+data:
+  one: first
+EOT
+}
+`, name)
+}
+
+func testAccKubernetesManifestConfig_modified(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_manifest" "test" {
+  manifest = jsonencode({
+    apiVersion = "v1"
+    kind       = "ConfigMap"
+    metadata = {
+      name = "%s"
+    }
+    data = {
+      one = "first"
+      two = "second"
+    }
+  })
+}
+`, name)
+}
+
+func testAccKubernetesManifestConfig_clusterScoped(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_manifest" "test" {
+  manifest = yamlencode({
+    apiVersion = "rbac.authorization.k8s.io/v1"
+    kind       = "ClusterRole"
+    metadata = {
+      name = "%s"
+    }
+    rules = [{
+      apiGroups = [""]
+      resources = ["pods"]
+      verbs     = ["get", "list"]
+    }]
+  })
+}
+`, name)
+}
@@ -12,7 +12,8 @@ import (
 	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	types "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 
 	copier "github.com/jinzhu/copier"
 )
@@ -146,16 +147,13 @@ func resourceKubernetesMutatingWebhookConfigurationCreate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	cfg := admissionregistrationv1.MutatingWebhookConfiguration{
-		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
-		Webhooks:   expandMutatingWebhooks(d.Get("webhook").([]interface{})),
-	}
+	cfg := expandMutatingWebhookConfiguration(d)
 
 	log.Printf("[INFO] Creating new MutatingWebhookConfiguration: %#v", cfg)
 
 	res := &admissionregistrationv1.MutatingWebhookConfiguration{}
 
-	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(meta.(KubeClientsets))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -163,14 +161,32 @@ func resourceKubernetesMutatingWebhookConfigurationCreate(ctx context.Context, d
 		requestv1beta1 := &admissionregistrationv1beta1.MutatingWebhookConfiguration{}
 		responsev1beta1 := &admissionregistrationv1beta1.MutatingWebhookConfiguration{}
 		copier.Copy(requestv1beta1, cfg)
-		responsev1beta1, err = conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Create(ctx, requestv1beta1, metav1.CreateOptions{})
+		data, apply, err := createPatch(ctx, meta, requestv1beta1)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if apply {
+			responsev1beta1, err = conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Patch(ctx, requestv1beta1.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+		} else {
+			responsev1beta1, err = conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Create(ctx, requestv1beta1, metav1.CreateOptions{})
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		copier.Copy(res, responsev1beta1)
 	} else {
-		res, err = conn.AdmissionregistrationV1().MutatingWebhookConfigurations().Create(ctx, &cfg, metav1.CreateOptions{})
-	}
-
-	if err != nil {
-		return diag.FromErr(err)
+		data, apply, err := createPatch(ctx, meta, cfg)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if apply {
+			res, err = conn.AdmissionregistrationV1().MutatingWebhookConfigurations().Patch(ctx, cfg.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+		} else {
+			res, err = conn.AdmissionregistrationV1().MutatingWebhookConfigurations().Create(ctx, cfg, metav1.CreateOptions{})
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	log.Printf("[INFO] Submitted new MutatingWebhookConfiguration: %#v", res)
@@ -180,6 +196,13 @@ func resourceKubernetesMutatingWebhookConfigurationCreate(ctx context.Context, d
 	return resourceKubernetesMutatingWebhookConfigurationRead(ctx, d, meta)
 }
 
+func expandMutatingWebhookConfiguration(d *schema.ResourceData) *admissionregistrationv1.MutatingWebhookConfiguration {
+	return &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Webhooks:   expandMutatingWebhooks(d.Get("webhook").([]interface{})),
+	}
+}
+
 func resourceKubernetesMutatingWebhookConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesMutatingWebhookConfigurationExists(ctx, d, meta)
 	if err != nil {
@@ -199,7 +222,7 @@ func resourceKubernetesMutatingWebhookConfigurationRead(ctx context.Context, d *
 	cfg := &admissionregistrationv1.MutatingWebhookConfiguration{}
 
 	log.Printf("[INFO] Reading MutatingWebhookConfiguration %s", name)
-	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(meta.(KubeClientsets))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -214,7 +237,7 @@ func resourceKubernetesMutatingWebhookConfigurationRead(ctx context.Context, d *
 		return diag.FromErr(err)
 	}
 
-	err = d.Set("metadata", flattenMetadata(cfg.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(cfg.ObjectMeta, d, meta))
 	if err != nil {
 		return nil
 	}
@@ -244,7 +267,7 @@ func resourceKubernetesMutatingWebhookConfigurationUpdate(ctx context.Context, d
 
 		patch := expandMutatingWebhooks(d.Get("webhook").([]interface{}))
 
-		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(meta.(KubeClientsets))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -259,7 +282,19 @@ func resourceKubernetesMutatingWebhookConfigurationUpdate(ctx context.Context, d
 		ops = append(ops, op)
 	}
 
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		cfg := expandMutatingWebhookConfiguration(d)
+		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(meta.(KubeClientsets))
+		if err != nil {
+			return nil, err
+		}
+		if useadmissionregistrationv1beta1 {
+			requestv1beta1 := &admissionregistrationv1beta1.MutatingWebhookConfiguration{}
+			copier.Copy(requestv1beta1, cfg)
+			return requestv1beta1, nil
+		}
+		return cfg, nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
@@ -269,16 +304,16 @@ func resourceKubernetesMutatingWebhookConfigurationUpdate(ctx context.Context, d
 
 	res := &admissionregistrationv1.MutatingWebhookConfiguration{}
 
-	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(meta.(KubeClientsets))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	if useadmissionregistrationv1beta1 {
 		responsev1beta1 := &admissionregistrationv1beta1.MutatingWebhookConfiguration{}
-		responsev1beta1, err = conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+		responsev1beta1, err = conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 		copier.Copy(res, responsev1beta1)
 	} else {
-		res, err = conn.AdmissionregistrationV1().MutatingWebhookConfigurations().Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+		res, err = conn.AdmissionregistrationV1().MutatingWebhookConfigurations().Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	}
 	if err != nil {
 		return diag.Errorf("Failed to update MutatingWebhookConfiguration: %s", err)
@@ -298,7 +333,7 @@ func resourceKubernetesMutatingWebhookConfigurationDelete(ctx context.Context, d
 	name := d.Id()
 
 	log.Printf("[INFO] Deleting MutatingWebhookConfiguration: %#v", name)
-	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(meta.(KubeClientsets))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -327,7 +362,7 @@ func resourceKubernetesMutatingWebhookConfigurationExists(ctx context.Context, d
 
 	log.Printf("[INFO] Checking MutatingWebhookConfiguration %s", name)
 
-	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(meta.(KubeClientsets))
 	if err != nil {
 		return false, err
 	}
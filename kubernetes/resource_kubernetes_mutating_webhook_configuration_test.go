@@ -127,7 +127,7 @@ func testAccCheckKubernetesMutatingWebhookConfigurationDestroy(s *terraform.Stat
 
 		name := rs.Primary.ID
 
-		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(testAccProvider.Meta().(KubeClientsets))
 		if err != nil {
 			return err
 		}
@@ -165,7 +165,7 @@ func testAccCheckKubernetesMutatingWebhookConfigurationExists(n string) resource
 
 		name := rs.Primary.ID
 
-		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(testAccProvider.Meta().(KubeClientsets))
 		if err != nil {
 			return err
 		}
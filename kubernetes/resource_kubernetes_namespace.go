@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,7 +14,10 @@ import (
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
 )
 
 func resourceKubernetesNamespace() *schema.Resource {
@@ -28,8 +32,15 @@ func resourceKubernetesNamespace() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchema("namespace", true),
+			"wait_for_default_service_account": {
+				Type:        schema.TypeBool,
+				Description: "Wait for the default service account to be created in the namespace, so that pods can be scheduled in it right away.",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 	}
@@ -41,21 +52,50 @@ func resourceKubernetesNamespaceCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	namespace := api.Namespace{
-		ObjectMeta: metadata,
-	}
+	namespace := expandNamespace(d)
 	log.Printf("[INFO] Creating new namespace: %#v", namespace)
-	out, err := conn.CoreV1().Namespaces().Create(ctx, &namespace, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, namespace)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.Namespace
+	if apply {
+		out, err = conn.CoreV1().Namespaces().Patch(ctx, namespace.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.CoreV1().Namespaces().Create(ctx, namespace, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Submitted new namespace: %#v", out)
 	d.SetId(out.Name)
 
+	if d.Get("wait_for_default_service_account").(bool) {
+		log.Printf("[DEBUG] Waiting for default service account to be created in namespace %s", out.Name)
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			_, err := conn.CoreV1().ServiceAccounts(out.Name).Get(ctx, "default", metav1.GetOptions{})
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return resource.RetryableError(fmt.Errorf("Default service account does not exist yet in namespace %s", out.Name))
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceKubernetesNamespaceRead(ctx, d, meta)
 }
 
+func expandNamespace(d *schema.ResourceData) *api.Namespace {
+	return &api.Namespace{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+	}
+}
+
 func resourceKubernetesNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesNamespaceExists(ctx, d, meta)
 	if err != nil {
@@ -78,7 +118,7 @@ func resourceKubernetesNamespaceRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received namespace: %#v", namespace)
-	err = d.Set("metadata", flattenMetadata(namespace.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(namespace.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -93,13 +133,15 @@ func resourceKubernetesNamespaceUpdate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandNamespace(d), nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 
 	log.Printf("[INFO] Updating namespace: %s", ops)
-	out, err := conn.CoreV1().Namespaces().Patch(ctx, d.Id(), pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.CoreV1().Namespaces().Patch(ctx, d.Id(), patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -143,6 +185,13 @@ func resourceKubernetesNamespaceDelete(ctx context.Context, d *schema.ResourceDa
 	}
 	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
+		if _, ok := err.(*resource.TimeoutError); ok {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Namespace %s is still terminating: %s", name, err),
+				Detail:   namespaceTerminationDiagnostics(meta, name),
+			}}
+		}
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Namespace %s deleted", name)
@@ -151,6 +200,72 @@ func resourceKubernetesNamespaceDelete(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
+// namespaceTerminationDiagnostics reports the conditions blocking the termination
+// of a namespace, and the objects left in it. Namespaced resources are found through
+// discovery, so that objects of custom resources holding finalizers are listed too.
+func namespaceTerminationDiagnostics(meta interface{}, name string) string {
+	// The context of the delete operation has usually expired at this point
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err.Error()
+	}
+	ns, err := conn.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Sprintf("Failed to read namespace: %s", err)
+	}
+
+	var remaining []namespaceRemainingObject
+	var lookupErrors []string
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return err.Error()
+	}
+	disco, err := meta.(KubeClientsets).DiscoveryClient()
+	if err != nil {
+		return err.Error()
+	}
+	// Discovery may fail for some groups, e.g. an unavailable aggregated API,
+	// while the resources of all other groups are still returned.
+	lists, err := disco.ServerPreferredNamespacedResources()
+	if err != nil {
+		lookupErrors = append(lookupErrors, fmt.Sprintf("Discovery failed: %s", err))
+	}
+	for _, list := range discovery.FilteredBy(discovery.SupportsAllVerbs{Verbs: []string{"list"}}, lists) {
+		gv, err := apimachineryschema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, r := range list.APIResources {
+			gvr := gv.WithResource(r.Name)
+			objs, err := dc.Resource(gvr).Namespace(name).List(ctx, metav1.ListOptions{Limit: maxNamespaceRemainingObjects})
+			if err != nil {
+				log.Printf("[DEBUG] Failed to list %s in namespace %s: %s", gvr, name, err)
+				continue
+			}
+			resourceName := r.Name
+			if gv.Group != "" {
+				resourceName += "." + gv.Group
+			}
+			for _, obj := range objs.Items {
+				remaining = append(remaining, namespaceRemainingObject{
+					Resource:   resourceName,
+					Name:       obj.GetName(),
+					Finalizers: obj.GetFinalizers(),
+				})
+			}
+		}
+	}
+
+	detail := describeNamespaceTermination(ns, remaining)
+	if len(lookupErrors) > 0 {
+		detail = strings.Join(append(lookupErrors, detail), "\n")
+	}
+	return detail
+}
+
 func resourceKubernetesNamespaceExists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
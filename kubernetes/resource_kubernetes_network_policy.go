@@ -10,7 +10,8 @@ import (
 	api "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // Use generated swagger docs from kubernetes' client-go to avoid copy/pasting them here
@@ -241,18 +242,21 @@ func resourceKubernetesNetworkPolicyCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandNetworkPolicySpec(d.Get("spec").([]interface{}))
+	svc, err := expandNetworkPolicy(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	svc := api.NetworkPolicy{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 	log.Printf("[INFO] Creating new network policy: %#v", svc)
-	out, err := conn.NetworkingV1().NetworkPolicies(metadata.Namespace).Create(ctx, &svc, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, svc)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.NetworkPolicy
+	if apply {
+		out, err = conn.NetworkingV1().NetworkPolicies(svc.Namespace).Patch(ctx, svc.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.NetworkingV1().NetworkPolicies(svc.Namespace).Create(ctx, svc, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -263,6 +267,17 @@ func resourceKubernetesNetworkPolicyCreate(ctx context.Context, d *schema.Resour
 	return resourceKubernetesNetworkPolicyRead(ctx, d, meta)
 }
 
+func expandNetworkPolicy(d *schema.ResourceData) (*api.NetworkPolicy, error) {
+	spec, err := expandNetworkPolicySpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &api.NetworkPolicy{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesNetworkPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesNetworkPolicyExists(ctx, d, meta)
 	if err != nil {
@@ -288,7 +303,7 @@ func resourceKubernetesNetworkPolicyRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received network policy: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(svc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -322,12 +337,14 @@ func resourceKubernetesNetworkPolicyUpdate(ctx context.Context, d *schema.Resour
 		}
 		ops = append(ops, *diffOps...)
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandNetworkPolicy(d)
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating network policy %q: %v", name, string(data))
-	out, err := conn.NetworkingV1().NetworkPolicies(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.NetworkingV1().NetworkPolicies(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update network policy: %s", err)
 	}
@@ -16,7 +16,8 @@ import (
 	api "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
@@ -208,18 +209,22 @@ func resourceKubernetesPersistentVolumeCreate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandPersistentVolumeSpec(d.Get("spec").([]interface{}))
+	volume, err := expandPersistentVolume(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	volume := api.PersistentVolume{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 
 	log.Printf("[INFO] Creating new persistent volume: %#v", volume)
-	out, err := conn.CoreV1().PersistentVolumes().Create(ctx, &volume, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, volume)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.PersistentVolume
+	if apply {
+		out, err = conn.CoreV1().PersistentVolumes().Patch(ctx, volume.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.CoreV1().PersistentVolumes().Create(ctx, volume, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -230,7 +235,7 @@ func resourceKubernetesPersistentVolumeCreate(ctx context.Context, d *schema.Res
 		Pending: []string{"Pending"},
 		Timeout: d.Timeout(schema.TimeoutCreate),
 		Refresh: func() (interface{}, string, error) {
-			out, err := conn.CoreV1().PersistentVolumes().Get(ctx, metadata.Name, metav1.GetOptions{})
+			out, err := conn.CoreV1().PersistentVolumes().Get(ctx, volume.Name, metav1.GetOptions{})
 			if err != nil {
 				log.Printf("[ERROR] Received error: %#v", err)
 				return out, "Error", err
@@ -252,6 +257,17 @@ func resourceKubernetesPersistentVolumeCreate(ctx context.Context, d *schema.Res
 	return resourceKubernetesPersistentVolumeRead(ctx, d, meta)
 }
 
+func expandPersistentVolume(d *schema.ResourceData) (*api.PersistentVolume, error) {
+	spec, err := expandPersistentVolumeSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &api.PersistentVolume{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesPersistentVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesPersistentVolumeExists(ctx, d, meta)
 	if err != nil {
@@ -274,7 +290,7 @@ func resourceKubernetesPersistentVolumeRead(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received persistent volume: %#v", volume)
-	err = d.Set("metadata", flattenMetadata(volume.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(volume.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -300,13 +316,15 @@ func resourceKubernetesPersistentVolumeUpdate(ctx context.Context, d *schema.Res
 		}
 		ops = append(ops, specOps...)
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandPersistentVolume(d)
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 
 	log.Printf("[INFO] Updating persistent volume %s: %s", d.Id(), ops)
-	out, err := conn.CoreV1().PersistentVolumes().Patch(ctx, d.Id(), pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.CoreV1().PersistentVolumes().Patch(ctx, d.Id(), patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.FromErr(err)
 	}
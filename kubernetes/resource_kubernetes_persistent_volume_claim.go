@@ -14,7 +14,9 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
@@ -27,6 +29,7 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 		Optional:    true,
 		Default:     true,
 	}
+	fields["status"] = persistentVolumeClaimStatusSchema()
 	return &schema.Resource{
 		CreateContext: resourceKubernetesPersistentVolumeClaimCreate,
 		ReadContext:   resourceKubernetesPersistentVolumeClaimRead,
@@ -41,12 +44,14 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: fields,
 
 		// All fields of Spec are immutable after creation, except for resources.requests.storage.
-		// Storage can only be increased in place. A new object will be created when the storage is decreased.
+		// Storage can only be increased in place, when the storage class of the claim allows volume expansion.
+		// A new object will be created when the storage is decreased or can't be expanded.
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 			// Skip custom logic for resource creation.
 			if diff.Id() == "" {
@@ -62,7 +67,7 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 				}
 				return nil
 			}
-			if diff.HasChange(key) {
+			if diff.HasChange(key) && diff.NewValueKnown(subKeyStorage) {
 				old, new := diff.GetChange(subKeyStorage)
 				oldStorageQuantity, err := k8sresource.ParseQuantity(old.(string))
 				if err != nil {
@@ -72,13 +77,22 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 				if err != nil {
 					return err
 				}
-				if newStorageQuantity.Cmp(oldStorageQuantity) == -1 {
+				switch newStorageQuantity.Cmp(oldStorageQuantity) {
+				case -1:
 					log.Printf("[DEBUG] CustomizeDiff spec.resources.requests.storage: field can not be less than previous value")
 					log.Printf("[DEBUG] CustomizeDiff creating new PVC with size: %v", new)
-					err := diff.ForceNew(key)
+					return diff.ForceNew(key)
+				case 1:
+					className, _ := diff.GetChange("spec.0.storage_class_name")
+					allowed, err := storageClassAllowsVolumeExpansion(ctx, meta, className.(string))
 					if err != nil {
 						return err
 					}
+					if !allowed {
+						log.Printf("[DEBUG] CustomizeDiff spec.resources.requests.storage: storage class %q doesn't allow volume expansion", className)
+						log.Printf("[DEBUG] CustomizeDiff creating new PVC with size: %v", new)
+						return diff.ForceNew(key)
+					}
 				}
 			}
 			return nil
@@ -86,6 +100,33 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 	}
 }
 
+// storageClassAllowsVolumeExpansion reports whether the claims of the storage
+// class can be expanded in place. Claims without a storage class can't be.
+// While the provider configuration is not known, the class can't be read,
+// so the resize fails to plan rather than guessing whether the claim is recreated.
+func storageClassAllowsVolumeExpansion(ctx context.Context, meta interface{}, name string) (bool, error) {
+	if name == "" {
+		return false, nil
+	}
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if _, unknown := err.(*unknownProviderConfigError); unknown {
+		return false, fmt.Errorf("Can't check whether storage class %q allows expanding the claim in place, "+
+			"which decides whether the claim is recreated, until the cluster is configured. "+
+			"Apply the changes the provider configuration depends on first, e.g. with -target. %s", name, err)
+	}
+	if err != nil {
+		return false, err
+	}
+	class, err := conn.StorageV1().StorageClasses().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, fmt.Errorf("Storage class %q of the claim not found, can't check whether it allows volume expansion", name)
+		}
+		return false, fmt.Errorf("Failed to read storage class %q to check whether it allows volume expansion: %s", name, err)
+	}
+	return class.AllowVolumeExpansion != nil && *class.AllowVolumeExpansion, nil
+}
+
 func resourceKubernetesPersistentVolumeClaimCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
@@ -100,7 +141,16 @@ func resourceKubernetesPersistentVolumeClaimCreate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Creating new persistent volume claim: %#v", claim)
-	out, err := conn.CoreV1().PersistentVolumeClaims(claim.Namespace).Create(ctx, claim, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, claim)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.PersistentVolumeClaim
+	if apply {
+		out, err = conn.CoreV1().PersistentVolumeClaims(claim.Namespace).Patch(ctx, claim.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.CoreV1().PersistentVolumeClaims(claim.Namespace).Create(ctx, claim, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -179,7 +229,7 @@ func resourceKubernetesPersistentVolumeClaimRead(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received persistent volume claim: %#v", claim)
-	err = d.Set("metadata", flattenMetadata(claim.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(claim.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -187,6 +237,10 @@ func resourceKubernetesPersistentVolumeClaimRead(ctx context.Context, d *schema.
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	err = d.Set("status", flattenPersistentVolumeClaimStatus(claim.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -204,7 +258,8 @@ func resourceKubernetesPersistentVolumeClaimUpdate(ctx context.Context, d *schem
 
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
 	// spec.resources.requests is the only editable field in Spec.
-	if d.HasChange("spec.0.resources.0.requests") {
+	resize := d.HasChange("spec.0.resources.0.requests")
+	if resize {
 		r := d.Get("spec.0.resources.0.requests").(map[string]interface{})
 		requests, err := expandMapToResourceList(r)
 		if err != nil {
@@ -215,19 +270,89 @@ func resourceKubernetesPersistentVolumeClaimUpdate(ctx context.Context, d *schem
 			Value: requests,
 		})
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandPersistentVolumeClaim(map[string]interface{}{
+			"metadata": d.Get("metadata"),
+			"spec":     d.Get("spec"),
+		})
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 
 	log.Printf("[INFO] Updating persistent volume claim: %s", ops)
-	out, err := conn.CoreV1().PersistentVolumeClaims(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.CoreV1().PersistentVolumeClaims(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Submitted updated persistent volume claim: %#v", out)
 
-	return resourceKubernetesPersistentVolumeClaimRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	if resize {
+		fsResizePending, err := waitForPersistentVolumeClaimResize(ctx, conn, namespace, name, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if fsResizePending {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("The file system of persistent volume claim %s is not resized yet", name),
+				Detail:   "The volume was expanded, but no running pod mounts the claim. Its file system will be resized when a pod mounts it.",
+			})
+		}
+	}
+
+	return append(diags, resourceKubernetesPersistentVolumeClaimRead(ctx, d, meta)...)
+}
+
+// waitForPersistentVolumeClaimResize waits for the volume of the claim to be
+// expanded and its file system to be resized. The file system is only resized
+// while a pod mounts the claim, so the wait ends early when none is running,
+// reporting that the file system resize is still pending.
+func waitForPersistentVolumeClaimResize(ctx context.Context, conn kubernetes.Interface, namespace, name string, timeout time.Duration) (bool, error) {
+	fsResizePending := false
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		claim, err := conn.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		condition, message := persistentVolumeClaimResizeProgress(claim)
+		switch condition {
+		case "":
+			fsResizePending = false
+			return nil
+		case api.PersistentVolumeClaimFileSystemResizePending:
+			mounted, err := isPersistentVolumeClaimMounted(ctx, conn, namespace, name)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if !mounted {
+				log.Printf("[DEBUG] Persistent volume claim %s isn't mounted by a running pod, its file system resize is pending", name)
+				fsResizePending = true
+				return nil
+			}
+		}
+		return resource.RetryableError(fmt.Errorf("Persistent volume claim %s is still being resized (%s): %s", name, condition, message))
+	})
+	return fsResizePending, err
+}
+
+func isPersistentVolumeClaimMounted(ctx context.Context, conn kubernetes.Interface, namespace, name string) (bool, error) {
+	pods, err := conn.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("Failed to list the pods mounting persistent volume claim %s: %s", name, err)
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != api.PodRunning {
+			continue
+		}
+		for _, v := range pod.Spec.Volumes {
+			if v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName == name {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
 }
 
 func resourceKubernetesPersistentVolumeClaimDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
@@ -16,6 +17,17 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+func TestStorageClassAllowsVolumeExpansion_unknownConfig(t *testing.T) {
+	meta := &kubeClientsets{configErr: &unknownProviderConfigError{Unknown: []string{"host"}}}
+	_, err := storageClassAllowsVolumeExpansion(context.Background(), meta, "standard")
+	if err == nil {
+		t.Fatal("Expected an error while the provider configuration is unknown")
+	}
+	if !regexp.MustCompile(`storage class "standard".*until the cluster is configured`).MatchString(err.Error()) {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
 func TestAccKubernetesPersistentVolumeClaim_basic(t *testing.T) {
 	var conf api.PersistentVolumeClaim
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
@@ -46,6 +58,8 @@ func TestAccKubernetesPersistentVolumeClaim_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.test", "spec.0.resources.#", "1"),
 					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.test", "spec.0.resources.0.requests.%", "1"),
 					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.test", "spec.0.resources.0.requests.storage", "5Gi"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.test", "spec.0.volume_mode", "Filesystem"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.test", "status.0.phase", "Pending"),
 				),
 			},
 			//      { // GKE specific check
@@ -525,6 +539,109 @@ func TestAccKubernetesPersistentVolumeClaim_expansionMinikube(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPersistentVolumeClaim_expansionNotAllowed(t *testing.T) {
+	var conf1, conf2 api.PersistentVolumeClaim
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     "kubernetes_persistent_volume_claim.test",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPersistentVolumeClaimDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPersistentVolumeClaimConfig_expansionNotAllowed(name, "1Gi"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPersistentVolumeClaimExists("kubernetes_persistent_volume_claim.test", &conf1),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.test", "spec.0.resources.0.requests.storage", "1Gi"),
+				),
+			},
+			{ // PVC is recreated when its storage class doesn't allow volume expansion.
+				Config: testAccKubernetesPersistentVolumeClaimConfig_expansionNotAllowed(name, "2Gi"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPersistentVolumeClaimExists("kubernetes_persistent_volume_claim.test", &conf2),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.test", "spec.0.resources.0.requests.storage", "2Gi"),
+					testAccCheckKubernetesPersistentVolumeClaimForceNew(&conf1, &conf2, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPersistentVolumeClaim_expansionClassNotFound(t *testing.T) {
+	var conf api.PersistentVolumeClaim
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     "kubernetes_persistent_volume_claim.test",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPersistentVolumeClaimDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPersistentVolumeClaimConfig_expansionClassNotFound(name, "1Gi"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPersistentVolumeClaimExists("kubernetes_persistent_volume_claim.test", &conf),
+				),
+			},
+			{ // The claim isn't silently recreated when its storage class can't be read.
+				Config:      testAccKubernetesPersistentVolumeClaimConfig_expansionClassNotFound(name, "2Gi"),
+				ExpectError: regexp.MustCompile(`Storage class ".+" of the claim not found`),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPersistentVolumeClaim_dataSource(t *testing.T) {
+	var conf api.PersistentVolumeClaim
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     "kubernetes_persistent_volume_claim.clone",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPersistentVolumeClaimDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPersistentVolumeClaimConfig_dataSource(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPersistentVolumeClaimExists("kubernetes_persistent_volume_claim.clone", &conf),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.clone", "spec.0.volume_mode", "Filesystem"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.clone", "spec.0.data_source.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.clone", "spec.0.data_source.0.kind", "PersistentVolumeClaim"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.clone", "spec.0.data_source.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPersistentVolumeClaim_dataSourceRef(t *testing.T) {
+	var conf api.PersistentVolumeClaim
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfClusterVersionLessThan(t, "1.24.0") },
+		IDRefreshName:     "kubernetes_persistent_volume_claim.clone",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPersistentVolumeClaimDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPersistentVolumeClaimConfig_dataSourceRef(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPersistentVolumeClaimExists("kubernetes_persistent_volume_claim.clone", &conf),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.clone", "spec.0.data_source_ref.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.clone", "spec.0.data_source_ref.0.kind", "PersistentVolumeClaim"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.clone", "spec.0.data_source_ref.0.name", name),
+					// The API server mirrors a core data source reference to data_source
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.clone", "spec.0.data_source.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.clone", "spec.0.data_source.0.name", name),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesPersistentVolumeClaim_regression(t *testing.T) {
 	var conf1, conf2 api.PersistentVolumeClaim
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
@@ -1219,3 +1336,125 @@ func testAccKubernetesPersistentVolumeClaimConfig_regression(provider, name stri
 }
 `, provider, name)
 }
+
+func testAccKubernetesPersistentVolumeClaimConfig_dataSource(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_persistent_volume_claim" "source" {
+  metadata {
+    name = "%[1]s"
+  }
+  spec {
+    access_modes = ["ReadWriteOnce"]
+    resources {
+      requests = {
+        storage = "1Gi"
+      }
+    }
+  }
+  wait_until_bound = false
+}
+
+resource "kubernetes_persistent_volume_claim" "clone" {
+  metadata {
+    name = "%[1]s-clone"
+  }
+  spec {
+    access_modes = ["ReadWriteOnce"]
+    volume_mode  = "Filesystem"
+    resources {
+      requests = {
+        storage = "1Gi"
+      }
+    }
+    data_source {
+      kind = "PersistentVolumeClaim"
+      name = kubernetes_persistent_volume_claim.source.metadata.0.name
+    }
+  }
+  wait_until_bound = false
+}
+`, name)
+}
+
+func testAccKubernetesPersistentVolumeClaimConfig_dataSourceRef(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_persistent_volume_claim" "source" {
+  metadata {
+    name = "%[1]s"
+  }
+  spec {
+    access_modes = ["ReadWriteOnce"]
+    resources {
+      requests = {
+        storage = "1Gi"
+      }
+    }
+  }
+  wait_until_bound = false
+}
+
+resource "kubernetes_persistent_volume_claim" "clone" {
+  metadata {
+    name = "%[1]s-clone"
+  }
+  spec {
+    access_modes = ["ReadWriteOnce"]
+    resources {
+      requests = {
+        storage = "1Gi"
+      }
+    }
+    data_source_ref {
+      kind = "PersistentVolumeClaim"
+      name = kubernetes_persistent_volume_claim.source.metadata.0.name
+    }
+  }
+  wait_until_bound = false
+}
+`, name)
+}
+
+func testAccKubernetesPersistentVolumeClaimConfig_expansionNotAllowed(name, requests string) string {
+	return fmt.Sprintf(`resource "kubernetes_storage_class" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+  allow_volume_expansion = false
+  storage_provisioner    = "kubernetes.io/no-provisioner"
+  volume_binding_mode    = "WaitForFirstConsumer"
+}
+
+resource "kubernetes_persistent_volume_claim" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+  spec {
+    access_modes       = ["ReadWriteOnce"]
+    storage_class_name = kubernetes_storage_class.test.metadata.0.name
+    resources {
+      requests = {
+        storage = "%[2]s"
+      }
+    }
+  }
+  wait_until_bound = false
+}
+`, name, requests)
+}
+
+func testAccKubernetesPersistentVolumeClaimConfig_expansionClassNotFound(name, requests string) string {
+	return fmt.Sprintf(`resource "kubernetes_persistent_volume_claim" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+  spec {
+    access_modes       = ["ReadWriteOnce"]
+    storage_class_name = "%[1]s-missing"
+    resources {
+      requests = {
+        storage = "%[2]s"
+      }
+    }
+  }
+  wait_until_bound = false
+}
+`, name, requests)
+}
@@ -2,14 +2,17 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	api "k8s.io/api/policy/v1beta1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	api "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 )
 
@@ -19,6 +22,8 @@ var (
 	podDisruptionBudgetSpecMaxUnavailableDoc = api.PodDisruptionBudget{}.SwaggerDoc()["maxUnavailable"]
 	podDisruptionBudgetSpecMinAvailableDoc   = api.PodDisruptionBudget{}.SwaggerDoc()["minAvailable"]
 	podDisruptionBudgetSpecSelectorDoc       = api.PodDisruptionBudget{}.SwaggerDoc()["selector"]
+	podDisruptionBudgetStatusDoc             = api.PodDisruptionBudget{}.SwaggerDoc()["status"]
+	podDisruptionBudgetStatusFieldsDoc       = api.PodDisruptionBudgetStatus{}.SwaggerDoc()
 )
 
 func resourceKubernetesPodDisruptionBudget() *schema.Resource {
@@ -68,6 +73,42 @@ func resourceKubernetesPodDisruptionBudget() *schema.Resource {
 								Schema: labelSelectorFields(false),
 							},
 						},
+						"unhealthy_pod_eviction_policy": {
+							Type:         schema.TypeString,
+							Description:  "Criteria for when unhealthy pods should be considered for eviction. Valid values are `IfHealthyBudget` and `AlwaysAllow`. Requires policy/v1 and Kubernetes 1.26 or later.",
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"IfHealthyBudget", "AlwaysAllow"}, false),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: podDisruptionBudgetStatusDoc,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"current_healthy": {
+							Type:        schema.TypeInt,
+							Description: podDisruptionBudgetStatusFieldsDoc["currentHealthy"],
+							Computed:    true,
+						},
+						"desired_healthy": {
+							Type:        schema.TypeInt,
+							Description: podDisruptionBudgetStatusFieldsDoc["desiredHealthy"],
+							Computed:    true,
+						},
+						"disruptions_allowed": {
+							Type:        schema.TypeInt,
+							Description: podDisruptionBudgetStatusFieldsDoc["disruptionsAllowed"],
+							Computed:    true,
+						},
+						"expected_pods": {
+							Type:        schema.TypeInt,
+							Description: podDisruptionBudgetStatusFieldsDoc["expectedPods"],
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -76,24 +117,32 @@ func resourceKubernetesPodDisruptionBudget() *schema.Resource {
 }
 
 func resourceKubernetesPodDisruptionBudgetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		pdb, err := expandPodDisruptionBudget(d)
+		if err != nil {
+			return nil, err
+		}
+		useV1beta1, err := usePolicyV1beta1PodDisruptionBudget(meta.(KubeClientsets))
+		if err != nil {
+			return nil, err
+		}
+		if useV1beta1 {
+			return convertPodDisruptionBudgetToV1beta1(pdb), nil
+		}
+		return pdb, nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 
 	log.Printf("[INFO] Updating pod disruption budget %s: %s", d.Id(), ops)
-	out, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := patchPodDisruptionBudget(ctx, meta.(KubeClientsets), namespace, name, patchType, data)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -105,23 +154,13 @@ func resourceKubernetesPodDisruptionBudgetUpdate(ctx context.Context, d *schema.
 }
 
 func resourceKubernetesPodDisruptionBudgetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandPodDisruptionBudgetSpec(d.Get("spec").([]interface{}))
+	pdb, err := expandPodDisruptionBudget(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	pdb := api.PodDisruptionBudget{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 
 	log.Printf("[INFO] Creating new pod disruption budget: %#v", pdb)
-	out, err := conn.PolicyV1beta1().PodDisruptionBudgets(metadata.Namespace).Create(ctx, &pdb, metav1.CreateOptions{})
+	out, err := createPodDisruptionBudget(ctx, meta.(KubeClientsets), pdb)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -132,6 +171,17 @@ func resourceKubernetesPodDisruptionBudgetCreate(ctx context.Context, d *schema.
 	return resourceKubernetesPodDisruptionBudgetRead(ctx, d, meta)
 }
 
+func expandPodDisruptionBudget(d *schema.ResourceData) (*api.PodDisruptionBudget, error) {
+	spec, err := expandPodDisruptionBudgetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &api.PodDisruptionBudget{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesPodDisruptionBudgetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesPodDisruptionBudgetExists(ctx, d, meta)
 	if err != nil {
@@ -141,25 +191,20 @@ func resourceKubernetesPodDisruptionBudgetRead(ctx context.Context, d *schema.Re
 		d.SetId("")
 		return diag.Diagnostics{}
 	}
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Reading pod disruption budget %s", name)
-	pdb, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+	pdb, err := getPodDisruptionBudget(ctx, meta.(KubeClientsets), namespace, name)
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Received pod disruption budget: %#v", pdb)
-	err = d.Set("metadata", flattenMetadata(pdb.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(pdb.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -169,22 +214,22 @@ func resourceKubernetesPodDisruptionBudgetRead(ctx context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
-	return nil
-}
-
-func resourceKubernetesPodDisruptionBudgetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn, err := meta.(KubeClientsets).MainClientset()
+	err = d.Set("status", flattenPodDisruptionBudgetStatus(pdb.Status))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	return nil
+}
+
+func resourceKubernetesPodDisruptionBudgetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting pod disruption budget %#v", name)
-	err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = deletePodDisruptionBudget(ctx, meta.(KubeClientsets), namespace, name)
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
 		return diag.FromErr(err)
@@ -197,18 +242,13 @@ func resourceKubernetesPodDisruptionBudgetDelete(ctx context.Context, d *schema.
 }
 
 func resourceKubernetesPodDisruptionBudgetExists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn, err := meta.(KubeClientsets).MainClientset()
-	if err != nil {
-		return false, err
-	}
-
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return false, err
 	}
 
 	log.Printf("[INFO] Checking pod disruption budget %s", name)
-	_, err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+	_, err = getPodDisruptionBudget(ctx, meta.(KubeClientsets), namespace, name)
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return false, nil
@@ -217,3 +257,97 @@ func resourceKubernetesPodDisruptionBudgetExists(ctx context.Context, d *schema.
 	}
 	return true, err
 }
+
+func createPodDisruptionBudget(ctx context.Context, k KubeClientsets, pdb *api.PodDisruptionBudget) (*api.PodDisruptionBudget, error) {
+	conn, err := k.MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	useV1beta1, err := usePolicyV1beta1PodDisruptionBudget(k)
+	if err != nil {
+		return nil, err
+	}
+	if useV1beta1 {
+		if pdb.Spec.UnhealthyPodEvictionPolicy != nil {
+			return nil, fmt.Errorf("unhealthy_pod_eviction_policy requires a cluster serving policy/v1 pod disruption budgets")
+		}
+		in := convertPodDisruptionBudgetToV1beta1(pdb)
+		data, apply, err := createPatch(ctx, k, in)
+		if err != nil {
+			return nil, err
+		}
+		if apply {
+			out, err := conn.PolicyV1beta1().PodDisruptionBudgets(pdb.Namespace).Patch(ctx, in.Name, pkgApi.ApplyPatchType, data, metav1.PatchOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return convertPodDisruptionBudgetFromV1beta1(out), nil
+		}
+		out, err := conn.PolicyV1beta1().PodDisruptionBudgets(pdb.Namespace).Create(ctx, in, metav1.CreateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return convertPodDisruptionBudgetFromV1beta1(out), nil
+	}
+	data, apply, err := createPatch(ctx, k, pdb)
+	if err != nil {
+		return nil, err
+	}
+	if apply {
+		return conn.PolicyV1().PodDisruptionBudgets(pdb.Namespace).Patch(ctx, pdb.Name, pkgApi.ApplyPatchType, data, metav1.PatchOptions{})
+	}
+	return conn.PolicyV1().PodDisruptionBudgets(pdb.Namespace).Create(ctx, pdb, metav1.CreateOptions{})
+}
+
+func getPodDisruptionBudget(ctx context.Context, k KubeClientsets, namespace, name string) (*api.PodDisruptionBudget, error) {
+	conn, err := k.MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	useV1beta1, err := usePolicyV1beta1PodDisruptionBudget(k)
+	if err != nil {
+		return nil, err
+	}
+	if useV1beta1 {
+		out, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return convertPodDisruptionBudgetFromV1beta1(out), nil
+	}
+	return conn.PolicyV1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+func patchPodDisruptionBudget(ctx context.Context, k KubeClientsets, namespace, name string, patchType pkgApi.PatchType, data []byte) (*api.PodDisruptionBudget, error) {
+	conn, err := k.MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	useV1beta1, err := usePolicyV1beta1PodDisruptionBudget(k)
+	if err != nil {
+		return nil, err
+	}
+	if useV1beta1 {
+		out, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return convertPodDisruptionBudgetFromV1beta1(out), nil
+	}
+	return conn.PolicyV1().PodDisruptionBudgets(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
+}
+
+func deletePodDisruptionBudget(ctx context.Context, k KubeClientsets, namespace, name string) error {
+	conn, err := k.MainClientset()
+	if err != nil {
+		return err
+	}
+	useV1beta1, err := usePolicyV1beta1PodDisruptionBudget(k)
+	if err != nil {
+		return err
+	}
+	if useV1beta1 {
+		return conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	}
+	return conn.PolicyV1().PodDisruptionBudgets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
@@ -8,8 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	api "k8s.io/api/policy/v1beta1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/api/policy/v1"
 )
 
 func TestAccKubernetesPodDisruptionBudget_basic(t *testing.T) {
@@ -46,6 +45,8 @@ func TestAccKubernetesPodDisruptionBudget_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "spec.0.selector.0.match_labels.%", "1"),
 					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "spec.0.selector.0.match_labels.foo", "bar"),
 					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "spec.0.selector.0.match_expressions.#", "0"),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "status.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "status.0.expected_pods", "0"),
 				),
 			},
 			{
@@ -87,11 +88,6 @@ func TestAccKubernetesPodDisruptionBudget_basic(t *testing.T) {
 }
 
 func testAccCheckKubernetesPodDisruptionBudgetDestroy(s *terraform.State) error {
-	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
-
-	if err != nil {
-		return err
-	}
 	ctx := context.TODO()
 
 	for _, rs := range s.RootModule().Resources {
@@ -104,7 +100,7 @@ func testAccCheckKubernetesPodDisruptionBudgetDestroy(s *terraform.State) error
 			return err
 		}
 
-		resp, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+		resp, err := getPodDisruptionBudget(ctx, testAccProvider.Meta().(KubeClientsets), namespace, name)
 		if err == nil {
 			if resp.Namespace == namespace && resp.Name == name {
 				return fmt.Errorf("Pod Disruption Budget still exists: %s", rs.Primary.ID)
@@ -122,10 +118,6 @@ func testAccCheckKubernetesPodDisruptionBudgetExists(n string, obj *api.PodDisru
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
-		if err != nil {
-			return err
-		}
 		ctx := context.TODO()
 
 		namespace, name, err := idParts(rs.Primary.ID)
@@ -133,7 +125,7 @@ func testAccCheckKubernetesPodDisruptionBudgetExists(n string, obj *api.PodDisru
 			return err
 		}
 
-		out, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+		out, err := getPodDisruptionBudget(ctx, testAccProvider.Meta().(KubeClientsets), namespace, name)
 		if err != nil {
 			return err
 		}
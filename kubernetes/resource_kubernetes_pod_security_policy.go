@@ -10,7 +10,8 @@ import (
 	policy "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // Use generated swagger docs from kubernetes' client-go to avoid copy/pasting them here
@@ -370,21 +371,22 @@ func resourceKubernetesPodSecurityPolicyCreate(ctx context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandPodSecurityPolicySpec(d.Get("spec").([]interface{}))
-
+	psp, err := expandPodSecurityPolicy(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	psp := &policy.PodSecurityPolicy{
-		ObjectMeta: metadata,
-		Spec:       spec,
-	}
-
 	log.Printf("[INFO] Creating new PodSecurityPolicy: %#v", psp)
-	out, err := conn.PolicyV1beta1().PodSecurityPolicies().Create(ctx, psp, metav1.CreateOptions{})
-
+	data, apply, err := createPatch(ctx, meta, psp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *policy.PodSecurityPolicy
+	if apply {
+		out, err = conn.PolicyV1beta1().PodSecurityPolicies().Patch(ctx, psp.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.PolicyV1beta1().PodSecurityPolicies().Create(ctx, psp, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -394,6 +396,17 @@ func resourceKubernetesPodSecurityPolicyCreate(ctx context.Context, d *schema.Re
 	return resourceKubernetesPodSecurityPolicyRead(ctx, d, meta)
 }
 
+func expandPodSecurityPolicy(d *schema.ResourceData) (*policy.PodSecurityPolicy, error) {
+	spec, err := expandPodSecurityPolicySpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &policy.PodSecurityPolicy{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       spec,
+	}, nil
+}
+
 func resourceKubernetesPodSecurityPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesPodSecurityPolicyExists(ctx, d, meta)
 	if err != nil {
@@ -418,7 +431,7 @@ func resourceKubernetesPodSecurityPolicyRead(ctx context.Context, d *schema.Reso
 	}
 
 	log.Printf("[INFO] Received PodSecurityPolicy: %#v", psp)
-	err = d.Set("metadata", flattenMetadata(psp.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(psp.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -450,12 +463,14 @@ func resourceKubernetesPodSecurityPolicyUpdate(ctx context.Context, d *schema.Re
 		}
 		ops = append(ops, *diffOps...)
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandPodSecurityPolicy(d)
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating PodSecurityPolicy %q: %v", name, string(data))
-	out, err := conn.PolicyV1beta1().PodSecurityPolicies().Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.PolicyV1beta1().PodSecurityPolicies().Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update PodSecurityPolicy: %s", err)
 	}
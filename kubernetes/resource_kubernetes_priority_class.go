@@ -10,7 +10,8 @@ import (
 	api "k8s.io/api/scheduling/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesPriorityClass() *schema.Resource {
@@ -53,20 +54,19 @@ func resourceKubernetesPriorityClassCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	value := d.Get("value").(int)
-	description := d.Get("description").(string)
-	globalDefault := d.Get("global_default").(bool)
-
-	priorityClass := api.PriorityClass{
-		ObjectMeta:    metadata,
-		Description:   description,
-		GlobalDefault: globalDefault,
-		Value:         int32(value),
-	}
+	priorityClass := expandPriorityClass(d)
 
 	log.Printf("[INFO] Creating new priority class: %#v", priorityClass)
-	out, err := conn.SchedulingV1().PriorityClasses().Create(ctx, &priorityClass, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, priorityClass)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.PriorityClass
+	if apply {
+		out, err = conn.SchedulingV1().PriorityClasses().Patch(ctx, priorityClass.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.SchedulingV1().PriorityClasses().Create(ctx, priorityClass, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create priority class: %s", err)
 	}
@@ -76,6 +76,15 @@ func resourceKubernetesPriorityClassCreate(ctx context.Context, d *schema.Resour
 	return resourceKubernetesPriorityClassRead(ctx, d, meta)
 }
 
+func expandPriorityClass(d *schema.ResourceData) *api.PriorityClass {
+	return &api.PriorityClass{
+		ObjectMeta:    expandMetadata(d.Get("metadata").([]interface{})),
+		Description:   d.Get("description").(string),
+		GlobalDefault: d.Get("global_default").(bool),
+		Value:         int32(d.Get("value").(int)),
+	}
+}
+
 func resourceKubernetesPriorityClassRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesPriorityClassExists(ctx, d, meta)
 	if err != nil {
@@ -100,7 +109,7 @@ func resourceKubernetesPriorityClassRead(ctx context.Context, d *schema.Resource
 	}
 	log.Printf("[INFO] Received priority class: %#v", priorityClass)
 
-	err = d.Set("metadata", flattenMetadata(priorityClass.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(priorityClass.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -149,12 +158,14 @@ func resourceKubernetesPriorityClassUpdate(ctx context.Context, d *schema.Resour
 		})
 	}
 
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandPriorityClass(d), nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating priority class %q: %v", name, string(data))
-	out, err := conn.SchedulingV1().PriorityClasses().Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.SchedulingV1().PriorityClasses().Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update priority class: %s", err)
 	}
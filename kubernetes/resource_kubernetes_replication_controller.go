@@ -13,6 +13,7 @@ import (
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 )
@@ -113,20 +114,22 @@ func resourceKubernetesReplicationControllerCreate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-
-	spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}))
+	rc, err := expandReplicationController(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	rc := api.ReplicationController{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
-
 	log.Printf("[INFO] Creating new replication controller: %#v", rc)
-	out, err := conn.CoreV1().ReplicationControllers(metadata.Namespace).Create(ctx, &rc, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, rc)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.ReplicationController
+	if apply {
+		out, err = conn.CoreV1().ReplicationControllers(rc.Namespace).Patch(ctx, rc.Name, pkgApi.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.CoreV1().ReplicationControllers(rc.Namespace).Create(ctx, rc, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create replication controller: %s", err)
 	}
@@ -150,6 +153,17 @@ func resourceKubernetesReplicationControllerCreate(ctx context.Context, d *schem
 	return resourceKubernetesReplicationControllerRead(ctx, d, meta)
 }
 
+func expandReplicationController(d *schema.ResourceData) (*api.ReplicationController, error) {
+	spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &api.ReplicationController{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesReplicationControllerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesReplicationControllerExists(ctx, d, meta)
 	if err != nil {
@@ -177,7 +191,7 @@ func resourceKubernetesReplicationControllerRead(ctx context.Context, d *schema.
 	}
 	log.Printf("[INFO] Received replication controller: %#v", rc)
 
-	err = d.Set("metadata", flattenMetadata(rc.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(rc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -219,12 +233,14 @@ func resourceKubernetesReplicationControllerUpdate(ctx context.Context, d *schem
 			Value: spec,
 		})
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandReplicationController(d)
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating replication controller %q: %v", name, string(data))
-	out, err := conn.CoreV1().ReplicationControllers(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.CoreV1().ReplicationControllers(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update replication controller: %s", err)
 	}
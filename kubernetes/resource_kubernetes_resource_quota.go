@@ -14,7 +14,8 @@ import (
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesResourceQuota() *schema.Resource {
@@ -110,17 +111,21 @@ func resourceKubernetesResourceQuotaCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandResourceQuotaSpec(d.Get("spec").([]interface{}))
+	resQuota, err := expandResourceQuota(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	resQuota := api.ResourceQuota{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 	log.Printf("[INFO] Creating new resource quota: %#v", resQuota)
-	out, err := conn.CoreV1().ResourceQuotas(metadata.Namespace).Create(ctx, &resQuota, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, resQuota)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.ResourceQuota
+	if apply {
+		out, err = conn.CoreV1().ResourceQuotas(resQuota.Namespace).Patch(ctx, resQuota.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.CoreV1().ResourceQuotas(resQuota.Namespace).Create(ctx, resQuota, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create resource quota: %s", err)
 	}
@@ -132,11 +137,11 @@ func resourceKubernetesResourceQuotaCreate(ctx context.Context, d *schema.Resour
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
-		if resourceListEquals(spec.Hard, quota.Status.Hard) {
+		if resourceListEquals(resQuota.Spec.Hard, quota.Status.Hard) {
 			return nil
 		}
 		err = fmt.Errorf("Quotas don't match after creation.\nExpected: %#v\nGiven: %#v",
-			spec.Hard, quota.Status.Hard)
+			resQuota.Spec.Hard, quota.Status.Hard)
 		return resource.RetryableError(err)
 	})
 	if err != nil {
@@ -146,6 +151,17 @@ func resourceKubernetesResourceQuotaCreate(ctx context.Context, d *schema.Resour
 	return resourceKubernetesResourceQuotaRead(ctx, d, meta)
 }
 
+func expandResourceQuota(d *schema.ResourceData) (*api.ResourceQuota, error) {
+	spec, err := expandResourceQuotaSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &api.ResourceQuota{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesResourceQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesResourceQuotaExists(ctx, d, meta)
 	if err != nil {
@@ -181,7 +197,7 @@ func resourceKubernetesResourceQuotaRead(ctx context.Context, d *schema.Resource
 		}
 	}
 
-	err = d.Set("metadata", flattenMetadata(resQuota.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(resQuota.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -218,12 +234,14 @@ func resourceKubernetesResourceQuotaUpdate(ctx context.Context, d *schema.Resour
 		})
 		waitForChangedSpec = true
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandResourceQuota(d)
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating resource quota %q: %v", name, string(data))
-	out, err := conn.CoreV1().ResourceQuotas(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.CoreV1().ResourceQuotas(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update resource quota: %s", err)
 	}
@@ -10,7 +10,8 @@ import (
 	v1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesRole() *schema.Resource {
@@ -72,15 +73,18 @@ func resourceKubernetesRoleCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	rules := expandRules(d.Get("rule").([]interface{}))
-
-	role := v1.Role{
-		ObjectMeta: metadata,
-		Rules:      *rules,
-	}
+	role := expandRole(d)
 	log.Printf("[INFO] Creating new role: %#v", role)
-	out, err := conn.RbacV1().Roles(metadata.Namespace).Create(ctx, &role, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, role)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *v1.Role
+	if apply {
+		out, err = conn.RbacV1().Roles(role.Namespace).Patch(ctx, role.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.RbacV1().Roles(role.Namespace).Create(ctx, role, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -91,6 +95,13 @@ func resourceKubernetesRoleCreate(ctx context.Context, d *schema.ResourceData, m
 	return resourceKubernetesRoleRead(ctx, d, meta)
 }
 
+func expandRole(d *schema.ResourceData) *v1.Role {
+	return &v1.Role{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Rules:      *expandRules(d.Get("rule").([]interface{})),
+	}
+}
+
 func resourceKubernetesRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesRoleExists(ctx, d, meta)
 	if err != nil {
@@ -118,7 +129,7 @@ func resourceKubernetesRoleRead(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	log.Printf("[INFO] Received role: %#v", role)
-	err = d.Set("metadata", flattenMetadata(role.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(role.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -152,12 +163,14 @@ func resourceKubernetesRoleUpdate(ctx context.Context, d *schema.ResourceData, m
 		})
 	}
 
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandRole(d), nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating role %q: %v", name, string(data))
-	out, err := conn.RbacV1().Roles(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.RbacV1().Roles(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update role: %s", err)
 	}
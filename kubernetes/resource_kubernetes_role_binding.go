@@ -10,7 +10,8 @@ import (
 	api "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesRoleBinding() *schema.Resource {
@@ -54,15 +55,18 @@ func resourceKubernetesRoleBindingCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	binding := &api.RoleBinding{
-		ObjectMeta: metadata,
-		RoleRef:    expandRBACRoleRef(d.Get("role_ref").([]interface{})),
-		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
-	}
+	binding := expandRoleBinding(d)
 	log.Printf("[INFO] Creating new RoleBinding: %#v", binding)
-	out, err := conn.RbacV1().RoleBindings(metadata.Namespace).Create(ctx, binding, metav1.CreateOptions{})
-
+	data, apply, err := createPatch(ctx, meta, binding)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.RoleBinding
+	if apply {
+		out, err = conn.RbacV1().RoleBindings(binding.Namespace).Patch(ctx, binding.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.RbacV1().RoleBindings(binding.Namespace).Create(ctx, binding, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -72,6 +76,14 @@ func resourceKubernetesRoleBindingCreate(ctx context.Context, d *schema.Resource
 	return resourceKubernetesRoleBindingRead(ctx, d, meta)
 }
 
+func expandRoleBinding(d *schema.ResourceData) *api.RoleBinding {
+	return &api.RoleBinding{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		RoleRef:    expandRBACRoleRef(d.Get("role_ref").([]interface{})),
+		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
+	}
+}
+
 func resourceKubernetesRoleBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesRoleBindingExists(ctx, d, meta)
 	if err != nil {
@@ -99,7 +111,7 @@ func resourceKubernetesRoleBindingRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	log.Printf("[INFO] Received RoleBinding: %#v", binding)
-	err = d.Set("metadata", flattenMetadata(binding.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(binding.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -137,12 +149,14 @@ func resourceKubernetesRoleBindingUpdate(ctx context.Context, d *schema.Resource
 		diffOps := patchRbacSubject(d)
 		ops = append(ops, diffOps...)
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandRoleBinding(d), nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating RoleBinding %q: %v", name, string(data))
-	out, err := conn.RbacV1().RoleBindings(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.RbacV1().RoleBindings(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update RoleBinding: %s", err)
 	}
@@ -10,7 +10,8 @@ import (
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesSecret() *schema.Resource {
@@ -55,9 +56,35 @@ func resourceKubernetesSecretCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	secret := api.Secret{
-		ObjectMeta: metadata,
+	secret, err := expandSecret(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating new secret: %#v", secret)
+	data, apply, err := createPatch(ctx, meta, applySecret(secret.DeepCopy()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.Secret
+	if apply {
+		out, err = conn.CoreV1().Secrets(secret.Namespace).Patch(ctx, secret.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.CoreV1().Secrets(secret.Namespace).Create(ctx, secret, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Submitting new secret: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesSecretRead(ctx, d, meta)
+}
+
+func expandSecret(d *schema.ResourceData) (*api.Secret, error) {
+	secret := &api.Secret{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
 	}
 
 	if v, ok := d.GetOk("data"); ok {
@@ -72,7 +99,7 @@ func resourceKubernetesSecretCreate(ctx context.Context, d *schema.ResourceData,
 	if v, ok := d.GetOk("binary_data"); ok {
 		m, err := base64DecodeStringMap(v.(map[string]interface{}))
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, err
 		}
 		secret.Data = m
 	}
@@ -80,17 +107,23 @@ func resourceKubernetesSecretCreate(ctx context.Context, d *schema.ResourceData,
 	if v, ok := d.GetOk("type"); ok {
 		secret.Type = api.SecretType(v.(string))
 	}
+	return secret, nil
+}
 
-	log.Printf("[INFO] Creating new secret: %#v", secret)
-	out, err := conn.CoreV1().Secrets(metadata.Namespace).Create(ctx, &secret, metav1.CreateOptions{})
-	if err != nil {
-		return diag.FromErr(err)
+// applySecret moves the plain text data of the secret to its data, since
+// `stringData` isn't kept by the API server and so can't be owned when applied.
+func applySecret(secret *api.Secret) *api.Secret {
+	if len(secret.StringData) == 0 {
+		return secret
 	}
-
-	log.Printf("[INFO] Submitting new secret: %#v", out)
-	d.SetId(buildId(out.ObjectMeta))
-
-	return resourceKubernetesSecretRead(ctx, d, meta)
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	for k, v := range secret.StringData {
+		secret.Data[k] = []byte(v)
+	}
+	secret.StringData = nil
+	return secret
 }
 
 func resourceKubernetesSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -119,7 +152,7 @@ func resourceKubernetesSecretRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	log.Printf("[INFO] Received secret: %#v", secret)
-	err = d.Set("metadata", flattenMetadata(secret.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(secret.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -183,13 +216,19 @@ func resourceKubernetesSecretUpdate(ctx context.Context, d *schema.ResourceData,
 		Value: newData,
 	})
 
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		secret, err := expandSecret(d)
+		if err != nil {
+			return nil, err
+		}
+		return applySecret(secret), nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 
 	log.Printf("[INFO] Updating secret %q: %v", name, data)
-	out, err := conn.CoreV1().Secrets(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.CoreV1().Secrets(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update secret: %s", err)
 	}
@@ -14,7 +14,8 @@ import (
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesService() *schema.Resource {
@@ -238,49 +239,32 @@ func resourceKubernetesServiceCreate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	svc := api.Service{
-		ObjectMeta: metadata,
-		Spec:       expandServiceSpec(d.Get("spec").([]interface{})),
-	}
+	svc := expandService(d)
 	log.Printf("[INFO] Creating new service: %#v", svc)
-	out, err := conn.CoreV1().Services(metadata.Namespace).Create(ctx, &svc, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, svc)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.Service
+	if apply {
+		out, err = conn.CoreV1().Services(svc.Namespace).Patch(ctx, svc.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.CoreV1().Services(svc.Namespace).Create(ctx, svc, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Submitted new service: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
-	if out.Spec.Type == api.ServiceTypeLoadBalancer && d.Get("wait_for_load_balancer").(bool) {
-		log.Printf("[DEBUG] Waiting for load balancer to assign IP/hostname")
-
-		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			svc, err := conn.CoreV1().Services(out.Namespace).Get(ctx, out.Name, metav1.GetOptions{})
-			if err != nil {
-				log.Printf("[DEBUG] Received error: %#v", err)
-				return resource.NonRetryableError(err)
-			}
-
-			lbIngress := svc.Status.LoadBalancer.Ingress
-
-			log.Printf("[INFO] Received service status: %#v", svc.Status)
-			if len(lbIngress) > 0 {
-				return nil
-			}
+	return resourceKubernetesServiceRead(ctx, d, meta)
+}
 
-			return resource.RetryableError(fmt.Errorf(
-				"Waiting for service %q to assign IP/hostname for a load balancer", d.Id()))
-		})
-		if err != nil {
-			lastWarnings, wErr := getLastWarningsForObject(ctx, conn, out.ObjectMeta, "Service", 3)
-			if wErr != nil {
-				return diag.FromErr(wErr)
-			}
-			return diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))
-		}
+func expandService(d *schema.ResourceData) *api.Service {
+	return &api.Service{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandServiceSpec(d.Get("spec").([]interface{})),
 	}
-
-	return resourceKubernetesServiceRead(ctx, d, meta)
 }
 
 func resourceKubernetesServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -309,7 +293,7 @@ func resourceKubernetesServiceRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received service: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(svc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -346,7 +330,7 @@ func resourceKubernetesServiceUpdate(ctx context.Context, d *schema.ResourceData
 
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
 	if d.HasChange("spec") {
-		serverVersion, err := conn.ServerVersion()
+		serverVersion, err := meta.(KubeClientsets).ServerVersion()
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -356,12 +340,14 @@ func resourceKubernetesServiceUpdate(ctx context.Context, d *schema.ResourceData
 		}
 		ops = append(ops, diffOps...)
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandService(d), nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating service %q: %v", name, string(data))
-	out, err := conn.CoreV1().Services(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.CoreV1().Services(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update service: %s", err)
 	}
@@ -14,7 +14,8 @@ import (
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -82,22 +83,25 @@ func resourceKubernetesServiceAccountCreate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	svcAcc := api.ServiceAccount{
-		AutomountServiceAccountToken: ptrToBool(d.Get("automount_service_account_token").(bool)),
-		ObjectMeta:                   metadata,
-		ImagePullSecrets:             expandLocalObjectReferenceArray(d.Get("image_pull_secret").(*schema.Set).List()),
-		Secrets:                      expandServiceAccountSecrets(d.Get("secret").(*schema.Set).List(), ""),
-	}
+	svcAcc := expandServiceAccount(d)
 	log.Printf("[INFO] Creating new service account: %#v", svcAcc)
-	out, err := conn.CoreV1().ServiceAccounts(metadata.Namespace).Create(ctx, &svcAcc, metav1.CreateOptions{})
+	data, apply, err := createPatch(ctx, meta, svcAcc)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.ServiceAccount
+	if apply {
+		out, err = conn.CoreV1().ServiceAccounts(svcAcc.Namespace).Patch(ctx, svcAcc.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.CoreV1().ServiceAccounts(svcAcc.Namespace).Create(ctx, svcAcc, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Submitted new service account: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
-	secret, err := getServiceAccountDefaultSecret(ctx, out.Name, svcAcc, d.Timeout(schema.TimeoutCreate), conn)
+	secret, err := getServiceAccountDefaultSecret(ctx, out.Name, *svcAcc, d.Timeout(schema.TimeoutCreate), conn)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -216,6 +220,15 @@ func diffObjectReferences(origOrs []api.ObjectReference, ors []api.ObjectReferen
 	return diff
 }
 
+func expandServiceAccount(d *schema.ResourceData) *api.ServiceAccount {
+	return &api.ServiceAccount{
+		AutomountServiceAccountToken: ptrToBool(d.Get("automount_service_account_token").(bool)),
+		ObjectMeta:                   expandMetadata(d.Get("metadata").([]interface{})),
+		ImagePullSecrets:             expandLocalObjectReferenceArray(d.Get("image_pull_secret").(*schema.Set).List()),
+		Secrets:                      expandServiceAccountSecrets(d.Get("secret").(*schema.Set).List(), d.Get("default_secret_name").(string)),
+	}
+}
+
 func resourceKubernetesServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesServiceAccountExists(ctx, d, meta)
 	if err != nil {
@@ -242,7 +255,7 @@ func resourceKubernetesServiceAccountRead(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received service account: %#v", svcAcc)
-	err = d.Set("metadata", flattenMetadata(svcAcc.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(svcAcc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -310,12 +323,14 @@ func resourceKubernetesServiceAccountUpdate(ctx context.Context, d *schema.Resou
 			Value: v,
 		})
 	}
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandServiceAccount(d), nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating service account %q: %v", name, string(data))
-	out, err := conn.CoreV1().ServiceAccounts(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.CoreV1().ServiceAccounts(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update service account: %s", err)
 	}
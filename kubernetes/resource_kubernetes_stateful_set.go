@@ -13,11 +13,8 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes"
-	"k8s.io/kubectl/pkg/polymorphichelpers"
 )
 
 func resourceKubernetesStatefulSet() *schema.Resource {
@@ -75,19 +72,22 @@ func resourceKubernetesStatefulSetCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandStatefulSetSpec(d.Get("spec").([]interface{}))
+	statefulSet, err := expandStatefulSet(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	statefulSet := appsv1.StatefulSet{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 	log.Printf("[INFO] Creating new StatefulSet: %#v", statefulSet)
 
-	out, err := conn.AppsV1().StatefulSets(metadata.Namespace).Create(ctx, &statefulSet, metav1.CreateOptions{})
-
+	data, apply, err := createPatch(ctx, meta, statefulSet)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *appsv1.StatefulSet
+	if apply {
+		out, err = conn.AppsV1().StatefulSets(statefulSet.Namespace).Patch(ctx, statefulSet.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.AppsV1().StatefulSets(statefulSet.Namespace).Create(ctx, statefulSet, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -98,17 +98,6 @@ func resourceKubernetesStatefulSetCreate(ctx context.Context, d *schema.Resource
 
 	log.Printf("[INFO] StatefulSet %s created", id)
 
-	if d.Get("wait_for_rollout").(bool) {
-		log.Printf("[INFO] Waiting for StatefulSet %s to rollout", id)
-		namespace := out.ObjectMeta.Namespace
-		name := out.ObjectMeta.Name
-		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
-			retryUntilStatefulSetRolloutComplete(ctx, conn, namespace, name))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-
 	return resourceKubernetesStatefulSetRead(ctx, d, meta)
 }
 
@@ -134,6 +123,17 @@ func resourceKubernetesStatefulSetExists(ctx context.Context, d *schema.Resource
 	return true, err
 }
 
+func expandStatefulSet(d *schema.ResourceData) (*appsv1.StatefulSet, error) {
+	spec, err := expandStatefulSetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.StatefulSet{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesStatefulSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesStatefulSetExists(ctx, d, meta)
 	if err != nil {
@@ -167,7 +167,7 @@ func resourceKubernetesStatefulSetRead(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 	log.Printf("[INFO] Received stateful set: %#v", statefulSet)
-	if d.Set("metadata", flattenMetadata(statefulSet.ObjectMeta, d)) != nil {
+	if d.Set("metadata", flattenResourceMetadata(statefulSet.ObjectMeta, d, meta)) != nil {
 		return diag.Errorf("Error setting `metadata`: %+v", err)
 	}
 	sss, err := flattenStatefulSetSpec(statefulSet.Spec, d)
@@ -202,27 +202,19 @@ func resourceKubernetesStatefulSetUpdate(ctx context.Context, d *schema.Resource
 		ops = append(ops, specPatch...)
 	}
 
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandStatefulSet(d)
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations for StatefulSet: %s", err)
 	}
 	log.Printf("[INFO] Updating StatefulSet %q: %v", name, string(data))
-	out, err := conn.AppsV1().StatefulSets(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.AppsV1().StatefulSets(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update StatefulSet: %s", err)
 	}
 	log.Printf("[INFO] Submitted updated StatefulSet: %#v", out)
 
-	if d.Get("wait_for_rollout").(bool) {
-		log.Printf("[INFO] Waiting for StatefulSet %s to rollout", d.Id())
-		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
-			retryUntilStatefulSetRolloutComplete(ctx, conn, namespace, name))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		return diag.Diagnostics{}
-	}
-
 	return resourceKubernetesStatefulSetRead(ctx, d, meta)
 }
 
@@ -264,50 +256,3 @@ func resourceKubernetesStatefulSetDelete(ctx context.Context, d *schema.Resource
 
 	return nil
 }
-
-// retryUntilStatefulSetRolloutComplete checks if a given job finished its execution and is either in 'Complete' or 'Failed' state.
-func retryUntilStatefulSetRolloutComplete(ctx context.Context, conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
-	return func() *resource.RetryError {
-		res, err := conn.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		if res.Status.ReadyReplicas != *res.Spec.Replicas {
-			return resource.RetryableError(fmt.Errorf("StatefulSet %s/%s is not finished rolling out", ns, name))
-		}
-
-		// NOTE: This is what kubectl uses to determine if a rollout is done.
-		// We are using this here because the logic for determining if a StatefulSet
-		// is done is gnarly and we don't want to duplicate it in the provider.
-		gvk := appsv1.SchemeGroupVersion.WithKind("StatefulSet")
-		gk := gvk.GroupKind()
-		statusViewer, err := polymorphichelpers.StatusViewerFor(gk)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(res)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		// NOTE: For some reason, the Kind and apiVersion get lost when converting to unstructured.
-		obj["apiVersion"] = gvk.GroupVersion().String()
-		obj["kind"] = gvk.Kind
-		u := unstructured.Unstructured{Object: obj}
-
-		// NOTE: the revision parameter of the Status function below is not actually used.
-		// for StatefulSet so it is set to 0 here
-		_, done, err := statusViewer.Status(&u, 0)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		if done {
-			return nil
-		}
-
-		return resource.RetryableError(fmt.Errorf("StatefulSet %s/%s is not finished rolling out", ns, name))
-	}
-}
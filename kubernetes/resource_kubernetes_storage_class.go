@@ -14,7 +14,8 @@ import (
 	api "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesStorageClass() *schema.Resource {
@@ -118,12 +119,34 @@ func resourceKubernetesStorageClassCreate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	storageClass := expandStorageClass(d)
+
+	log.Printf("[INFO] Creating new storage class: %#v", storageClass)
+	data, apply, err := createPatch(ctx, meta, storageClass)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out *api.StorageClass
+	if apply {
+		out, err = conn.StorageV1().StorageClasses().Patch(ctx, storageClass.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+	} else {
+		out, err = conn.StorageV1().StorageClasses().Create(ctx, storageClass, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted new storage class: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesStorageClassRead(ctx, d, meta)
+}
+
+func expandStorageClass(d *schema.ResourceData) *api.StorageClass {
 	reclaimPolicy := v1.PersistentVolumeReclaimPolicy(d.Get("reclaim_policy").(string))
 	volumeBindingMode := api.VolumeBindingMode(d.Get("volume_binding_mode").(string))
 	allowVolumeExpansion := d.Get("allow_volume_expansion").(bool)
-	storageClass := api.StorageClass{
-		ObjectMeta:           metadata,
+	storageClass := &api.StorageClass{
+		ObjectMeta:           expandMetadata(d.Get("metadata").([]interface{})),
 		Provisioner:          d.Get("storage_provisioner").(string),
 		ReclaimPolicy:        &reclaimPolicy,
 		VolumeBindingMode:    &volumeBindingMode,
@@ -140,16 +163,7 @@ func resourceKubernetesStorageClassCreate(ctx context.Context, d *schema.Resourc
 	if v, ok := d.GetOk("allowed_topologies"); ok && len(v.([]interface{})) > 0 {
 		storageClass.AllowedTopologies = expandStorageClassAllowedTopologies(v.([]interface{}))
 	}
-
-	log.Printf("[INFO] Creating new storage class: %#v", storageClass)
-	out, err := conn.StorageV1().StorageClasses().Create(ctx, &storageClass, metav1.CreateOptions{})
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	log.Printf("[INFO] Submitted new storage class: %#v", out)
-	d.SetId(out.Name)
-
-	return resourceKubernetesStorageClassRead(ctx, d, meta)
+	return storageClass
 }
 
 func resourceKubernetesStorageClassRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -178,7 +192,7 @@ func resourceKubernetesStorageClassRead(ctx context.Context, d *schema.ResourceD
 
 	log.Printf("[INFO] Received storage class: %#v", storageClass)
 
-	err = d.Set("metadata", flattenMetadata(storageClass.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(storageClass.ObjectMeta, d, meta))
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)[0])
 	}
@@ -201,12 +215,14 @@ func resourceKubernetesStorageClassUpdate(ctx context.Context, d *schema.Resourc
 
 	name := d.Id()
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		return expandStorageClass(d), nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating storage class %q: %v", name, string(data))
-	out, err := conn.StorageV1().StorageClasses().Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	out, err := conn.StorageV1().StorageClasses().Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	if err != nil {
 		return diag.Errorf("Failed to update storage class: %s", err)
 	}
@@ -12,7 +12,8 @@ import (
 	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	types "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 
 	copier "github.com/jinzhu/copier"
 )
@@ -136,16 +137,13 @@ func resourceKubernetesValidatingWebhookConfigurationCreate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	cfg := admissionregistrationv1.ValidatingWebhookConfiguration{
-		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
-		Webhooks:   expandValidatingWebhooks(d.Get("webhook").([]interface{})),
-	}
+	cfg := expandValidatingWebhookConfiguration(d)
 
 	log.Printf("[INFO] Creating new ValidatingWebhookConfiguration: %#v", cfg)
 
 	res := &admissionregistrationv1.ValidatingWebhookConfiguration{}
 
-	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(meta.(KubeClientsets))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -153,14 +151,32 @@ func resourceKubernetesValidatingWebhookConfigurationCreate(ctx context.Context,
 		requestv1beta1 := &admissionregistrationv1beta1.ValidatingWebhookConfiguration{}
 		responsev1beta1 := &admissionregistrationv1beta1.ValidatingWebhookConfiguration{}
 		copier.Copy(requestv1beta1, cfg)
-		responsev1beta1, err = conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Create(ctx, requestv1beta1, metav1.CreateOptions{})
+		data, apply, err := createPatch(ctx, meta, requestv1beta1)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if apply {
+			responsev1beta1, err = conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Patch(ctx, requestv1beta1.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+		} else {
+			responsev1beta1, err = conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Create(ctx, requestv1beta1, metav1.CreateOptions{})
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		copier.Copy(res, responsev1beta1)
 	} else {
-		res, err = conn.AdmissionregistrationV1().ValidatingWebhookConfigurations().Create(ctx, &cfg, metav1.CreateOptions{})
-	}
-
-	if err != nil {
-		return diag.FromErr(err)
+		data, apply, err := createPatch(ctx, meta, cfg)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if apply {
+			res, err = conn.AdmissionregistrationV1().ValidatingWebhookConfigurations().Patch(ctx, cfg.Name, types.ApplyPatchType, data, metav1.PatchOptions{})
+		} else {
+			res, err = conn.AdmissionregistrationV1().ValidatingWebhookConfigurations().Create(ctx, cfg, metav1.CreateOptions{})
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	log.Printf("[INFO] Submitted new ValidatingWebhookConfiguration: %#v", res)
@@ -170,6 +186,13 @@ func resourceKubernetesValidatingWebhookConfigurationCreate(ctx context.Context,
 	return resourceKubernetesValidatingWebhookConfigurationRead(ctx, d, meta)
 }
 
+func expandValidatingWebhookConfiguration(d *schema.ResourceData) *admissionregistrationv1.ValidatingWebhookConfiguration {
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Webhooks:   expandValidatingWebhooks(d.Get("webhook").([]interface{})),
+	}
+}
+
 func resourceKubernetesValidatingWebhookConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesValidatingWebhookConfigurationExists(ctx, d, meta)
 	if err != nil {
@@ -189,7 +212,7 @@ func resourceKubernetesValidatingWebhookConfigurationRead(ctx context.Context, d
 	cfg := &admissionregistrationv1.ValidatingWebhookConfiguration{}
 
 	log.Printf("[INFO] Reading ValidatingWebhookConfiguration %s", name)
-	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(meta.(KubeClientsets))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -204,7 +227,7 @@ func resourceKubernetesValidatingWebhookConfigurationRead(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	err = d.Set("metadata", flattenMetadata(cfg.ObjectMeta, d))
+	err = d.Set("metadata", flattenResourceMetadata(cfg.ObjectMeta, d, meta))
 	if err != nil {
 		return nil
 	}
@@ -234,7 +257,7 @@ func resourceKubernetesValidatingWebhookConfigurationUpdate(ctx context.Context,
 
 		patch := expandValidatingWebhooks(d.Get("webhook").([]interface{}))
 
-		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(meta.(KubeClientsets))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -249,7 +272,19 @@ func resourceKubernetesValidatingWebhookConfigurationUpdate(ctx context.Context,
 		ops = append(ops, op)
 	}
 
-	data, err := ops.MarshalJSON()
+	patchType, data, err := updatePatch(ctx, meta, ops, func() (runtime.Object, error) {
+		cfg := expandValidatingWebhookConfiguration(d)
+		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(meta.(KubeClientsets))
+		if err != nil {
+			return nil, err
+		}
+		if useadmissionregistrationv1beta1 {
+			requestv1beta1 := &admissionregistrationv1beta1.ValidatingWebhookConfiguration{}
+			copier.Copy(requestv1beta1, cfg)
+			return requestv1beta1, nil
+		}
+		return cfg, nil
+	})
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
 	}
@@ -259,16 +294,16 @@ func resourceKubernetesValidatingWebhookConfigurationUpdate(ctx context.Context,
 
 	res := &admissionregistrationv1.ValidatingWebhookConfiguration{}
 
-	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(meta.(KubeClientsets))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	if useadmissionregistrationv1beta1 {
 		responsev1beta1 := &admissionregistrationv1beta1.ValidatingWebhookConfiguration{}
-		responsev1beta1, err = conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+		responsev1beta1, err = conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 		copier.Copy(res, responsev1beta1)
 	} else {
-		res, err = conn.AdmissionregistrationV1().ValidatingWebhookConfigurations().Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+		res, err = conn.AdmissionregistrationV1().ValidatingWebhookConfigurations().Patch(ctx, name, patchType, data, metav1.PatchOptions{})
 	}
 	if err != nil {
 		return diag.Errorf("Failed to update ValidatingWebhookConfiguration: %s", err)
@@ -288,7 +323,7 @@ func resourceKubernetesValidatingWebhookConfigurationDelete(ctx context.Context,
 	name := d.Id()
 
 	log.Printf("[INFO] Deleting ValidatingWebhookConfiguration: %#v", name)
-	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(meta.(KubeClientsets))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -317,7 +352,7 @@ func resourceKubernetesValidatingWebhookConfigurationExists(ctx context.Context,
 
 	log.Printf("[INFO] Checking ValidatingWebhookConfiguration %s", name)
 
-	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(meta.(KubeClientsets))
 	if err != nil {
 		return false, err
 	}
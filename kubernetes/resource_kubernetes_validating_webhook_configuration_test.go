@@ -309,7 +309,7 @@ func testAccCheckKubernetesValdiatingWebhookConfigurationDestroy(s *terraform.St
 
 		name := rs.Primary.ID
 
-		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(testAccProvider.Meta().(KubeClientsets))
 		if err != nil {
 			return err
 		}
@@ -347,7 +347,7 @@ func testAccCheckKubernetesValidatingWebhookConfigurationExists(n string) resour
 
 		name := rs.Primary.ID
 
-		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+		useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(testAccProvider.Meta().(KubeClientsets))
 		if err != nil {
 			return err
 		}
@@ -557,11 +557,7 @@ func testAccKubernetesValidatingWebhookConfigurationConfig_regression(provider,
 }
 
 func skipIfNotAdmissionRegistrationV1Beta1(t *testing.T) {
-	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
-	if err != nil {
-		t.Fatal(err)
-	}
-	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(testAccProvider.Meta().(KubeClientsets))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -571,11 +567,7 @@ func skipIfNotAdmissionRegistrationV1Beta1(t *testing.T) {
 }
 
 func skipIfNotAdmissionRegistrationV1(t *testing.T) {
-	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
-	if err != nil {
-		t.Fatal(err)
-	}
-	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(conn)
+	useadmissionregistrationv1beta1, err := useAdmissionregistrationV1beta1(testAccProvider.Meta().(KubeClientsets))
 	if err != nil {
 		t.Fatal(err)
 	}
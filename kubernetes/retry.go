@@ -0,0 +1,150 @@
+package kubernetes
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// defaultRetryStatusCodes are the HTTP status codes retried when the
+// `retry` block of the provider doesn't list any.
+var defaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+type retryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+	StatusCodes []int
+}
+
+func expandRetryPolicy(l []interface{}) (*retryPolicy, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	in := l[0].(map[string]interface{})
+	p := &retryPolicy{
+		MaxAttempts: in["max_attempts"].(int),
+		StatusCodes: defaultRetryStatusCodes,
+	}
+	var err error
+	p.Backoff, err = time.ParseDuration(in["backoff"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse backoff: %s", err)
+	}
+	p.MaxBackoff, err = time.ParseDuration(in["max_backoff"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse max_backoff: %s", err)
+	}
+	if v, ok := in["status_codes"].([]interface{}); ok && len(v) > 0 {
+		p.StatusCodes = make([]int, len(v))
+		for i, c := range v {
+			p.StatusCodes[i] = c.(int)
+		}
+	}
+	return p, nil
+}
+
+// retryRoundTripper retries the requests which fail with one of the retryable
+// status codes, waiting for the delay given by the Retry-After header of the
+// response, or else for an exponential backoff. Since a create request may have
+// been processed by the server when it fails with a server error, POST requests
+// are only retried when they were throttled.
+type retryRoundTripper struct {
+	policy retryPolicy
+	rt     http.RoundTripper
+}
+
+func newRetryRoundTripper(policy retryPolicy, rt http.RoundTripper) http.RoundTripper {
+	return &retryRoundTripper{
+		policy: policy,
+		rt:     rt,
+	}
+}
+
+func (t *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	backoff := t.policy.Backoff
+	for attempt := 1; ; attempt++ {
+		resp, err := t.rt.RoundTrip(req)
+		if err != nil || attempt >= t.policy.MaxAttempts || !t.shouldRetry(req, resp) {
+			return resp, err
+		}
+
+		delay, ok := retryAfter(resp)
+		if !ok {
+			delay = backoff
+			backoff *= 2
+			if backoff > t.policy.MaxBackoff {
+				backoff = t.policy.MaxBackoff
+			}
+		}
+
+		// The body must be replayed on the next attempt
+		r := req
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+
+		log.Printf("[DEBUG] Received status %d for %s %s, retrying in %s (attempt %d of %d)",
+			resp.StatusCode, req.Method, req.URL.Path, delay, attempt+1, t.policy.MaxAttempts)
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		req = r
+	}
+}
+
+func (t *retryRoundTripper) shouldRetry(req *http.Request, resp *http.Response) bool {
+	if req.Method == http.MethodPost && resp.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+	for _, c := range t.policy.StatusCodes {
+		if resp.StatusCode == c {
+			return true
+		}
+	}
+	return false
+}
+
+// retryAfter returns the delay requested by the Retry-After header of the
+// response, given either in seconds or as an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
@@ -0,0 +1,105 @@
+package kubernetes
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter(t *testing.T) {
+	testCases := []struct {
+		Header   string
+		Expected time.Duration
+		Ok       bool
+	}{
+		{"", 0, false},
+		{"3", 3 * time.Second, true},
+		{"0", 0, true},
+		{"-1", 0, false},
+		{"soon", 0, false},
+		{time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Header, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tc.Header != "" {
+				resp.Header.Set("Retry-After", tc.Header)
+			}
+			d, ok := retryAfter(resp)
+			if ok != tc.Ok || d != tc.Expected {
+				t.Fatalf("Expected (%s, %t), got (%s, %t)", tc.Expected, tc.Ok, d, ok)
+			}
+		})
+	}
+}
+
+func TestRetryRoundTripper(t *testing.T) {
+	var attempts int
+	var bodies []string
+	var statuses []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		status := statuses[attempts]
+		attempts++
+		if status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "0")
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryRoundTripper(retryPolicy{
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+		StatusCodes: defaultRetryStatusCodes,
+	}, http.DefaultTransport)}
+
+	testCases := []struct {
+		Name             string
+		Method           string
+		Statuses         []int
+		ExpectedAttempts int
+		ExpectedStatus   int
+	}{
+		{"throttled then succeeds", http.MethodPut, []int{429, 429, 200}, 3, 200},
+		{"server error then succeeds", http.MethodPatch, []int{503, 200}, 2, 200},
+		{"gives up after max attempts", http.MethodGet, []int{502, 502, 502}, 3, 502},
+		{"not retryable", http.MethodGet, []int{404}, 1, 404},
+		{"create is retried when throttled", http.MethodPost, []int{429, 201}, 2, 201},
+		{"create is not retried on server error", http.MethodPost, []int{500}, 1, 500},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			attempts = 0
+			bodies = nil
+			statuses = tc.Statuses
+
+			req, err := http.NewRequest(tc.Method, server.URL+"/api/v1/namespaces/default/configmaps", bytes.NewReader([]byte(`{"kind":"ConfigMap"}`)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.ExpectedStatus {
+				t.Fatalf("Expected status %d, got %d", tc.ExpectedStatus, resp.StatusCode)
+			}
+			if attempts != tc.ExpectedAttempts {
+				t.Fatalf("Expected %d attempts, got %d", tc.ExpectedAttempts, attempts)
+			}
+			for i, b := range bodies {
+				if b != `{"kind":"ConfigMap"}` {
+					t.Fatalf("Expected the body to be replayed on attempt %d, got %q", i+1, b)
+				}
+			}
+		})
+	}
+}
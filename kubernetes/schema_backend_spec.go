@@ -1,10 +1,15 @@
 package kubernetes
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	networking "k8s.io/api/networking/v1"
+)
 
 const defaultBackendDescription = `A default backend capable of servicing requests that don't match any rule. At least one of 'backend' or 'rules' must be specified. This field is optional to allow the loadbalancer controller or defaulting logic to specify a global default.`
 const ruleBackedDescription = `Backend defines the referenced service endpoint to which the traffic will be forwarded to.`
 
+// backendSpecFields is the extensions/v1beta1 backend schema, kept for state migrations.
 func backendSpecFields(description string) *schema.Schema {
 	s := &schema.Schema{
 		Type:        schema.TypeList,
@@ -30,3 +35,85 @@ func backendSpecFields(description string) *schema.Schema {
 
 	return s
 }
+
+func backendSpecFieldsV1(description string) *schema.Schema {
+	docIngressBackend := networking.IngressBackend{}.SwaggerDoc()
+	docIngressServiceBackend := networking.IngressServiceBackend{}.SwaggerDoc()
+	docServiceBackendPort := networking.ServiceBackendPort{}.SwaggerDoc()
+
+	s := &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		MaxItems:    1,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"service": {
+					Type:        schema.TypeList,
+					Description: docIngressBackend["service"],
+					MaxItems:    1,
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Description: docIngressServiceBackend["name"],
+								Required:    true,
+							},
+							"port": {
+								Type:        schema.TypeList,
+								Description: docIngressServiceBackend["port"],
+								MaxItems:    1,
+								Required:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name": {
+											Type:        schema.TypeString,
+											Description: docServiceBackendPort["name"],
+											Optional:    true,
+										},
+										"number": {
+											Type:         schema.TypeInt,
+											Description:  docServiceBackendPort["number"],
+											Optional:     true,
+											ValidateFunc: validatePortNum,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"resource": {
+					Type:        schema.TypeList,
+					Description: docIngressBackend["resource"],
+					MaxItems:    1,
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"api_group": {
+								Type:        schema.TypeString,
+								Description: "APIGroup is the group for the resource being referenced. If not specified, the resource must be in the core API group.",
+								Optional:    true,
+							},
+							"kind": {
+								Type:         schema.TypeString,
+								Description:  "Kind is the type of resource being referenced.",
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							"name": {
+								Type:         schema.TypeString,
+								Description:  "Name is the name of resource being referenced.",
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return s
+}
@@ -67,6 +67,12 @@ func cronJobSpecFields() map[string]*schema.Schema {
 			Default:     false,
 			Description: "This flag tells the controller to suspend subsequent executions, it does not apply to already started executions. Defaults to false.",
 		},
+		"time_zone": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The time zone name for the given schedule, e.g. Etc/UTC. If not specified, this will default to the time zone of the kube-controller-manager process. Requires Kubernetes 1.27 or later.",
+		},
 	}
 
 	return s
@@ -5,6 +5,8 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	batchv1 "k8s.io/api/batch/v1"
 )
 
 func jobMetadataSchema() *schema.Schema {
@@ -51,6 +53,14 @@ func jobSpecFields(specUpdatable bool) map[string]*schema.Schema {
 			Description:  "Specifies the number of retries before marking this job failed. Defaults to 6",
 		},
 		// This field is immutable in Jobs.
+		"backoff_limit_per_index": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validateTypeStringNullableInt,
+			Description:  "Specifies the number of retries of each index before marking it failed, instead of counting the retries of the whole job against `backoff_limit`. Requires the `Indexed` completion mode. More info: https://kubernetes.io/docs/concepts/workloads/controllers/job/#backoff-limit-per-index",
+		},
+		// This field is immutable in Jobs.
 		"completions": {
 			Type:         schema.TypeInt,
 			Optional:     true,
@@ -59,6 +69,15 @@ func jobSpecFields(specUpdatable bool) map[string]*schema.Schema {
 			ValidateFunc: validatePositiveInteger,
 			Description:  "Specifies the desired number of successfully finished pods the job should be run with. Setting to nil means that the success of any pod signals the success of all pods, and allows parallelism to have any positive value. Setting to 1 means that parallelism is limited to 1 and the success of that pod signals the success of the job. More info: https://kubernetes.io/docs/concepts/workloads/controllers/jobs-run-to-completion/",
 		},
+		// This field is immutable in Jobs.
+		"completion_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{string(batchv1.NonIndexedCompletion), string(batchv1.IndexedCompletion)}, false),
+			Description:  "Specifies how pod completions are tracked. With `NonIndexed`, the job is complete when `completions` pods succeeded. With `Indexed`, the pods get a completion index from 0 to `completions` - 1, available in their `batch.kubernetes.io/job-completion-index` annotation, and the job is complete when one pod succeeded for each index. More info: https://kubernetes.io/docs/concepts/workloads/controllers/job/#completion-mode",
+		},
 		"manual_selector": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -74,6 +93,27 @@ func jobSpecFields(specUpdatable bool) map[string]*schema.Schema {
 			Description:  "Specifies the maximum desired number of pods the job should run at any given time. The actual number of pods running in steady state will be less than this number when ((.spec.completions - .status.successful) < .spec.parallelism), i.e. when the work left to do is less than max parallelism. More info: https://kubernetes.io/docs/concepts/workloads/controllers/jobs-run-to-completion/",
 		},
 		// This field is immutable in Jobs.
+		"pod_failure_policy": {
+			Type:        schema.TypeList,
+			Description: "Specifies how failed pods are handled, depending on their exit codes and conditions. Failed pods not matching any rule are counted against `backoff_limit`. Requires `restart_policy` to be `Never`. More info: https://kubernetes.io/docs/concepts/workloads/controllers/job/#pod-failure-policy",
+			Optional:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rule": {
+						Type:        schema.TypeList,
+						Description: "The rules evaluated in order against each failed pod. The first matching rule decides what happens, the others are ignored.",
+						Required:    true,
+						ForceNew:    true,
+						Elem: &schema.Resource{
+							Schema: jobPodFailurePolicyRuleFields(),
+						},
+					},
+				},
+			},
+		},
+		// This field is immutable in Jobs.
 		"selector": {
 			Type:        schema.TypeList,
 			Description: "A label query over volumes to consider for binding.",
@@ -122,6 +162,14 @@ func jobSpecFields(specUpdatable bool) map[string]*schema.Schema {
 				},
 			},
 		},
+		// This field can be edited in place.
+		"suspend": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    false,
+			Default:     false,
+			Description: "Whether the job controller should stop creating pods. When the job is suspended, its active pods are terminated and `active_deadline_seconds` is reset when it's resumed. More info: https://kubernetes.io/docs/concepts/workloads/controllers/job/#suspending-a-job",
+		},
 		// PodTemplate fields are immutable in Jobs.
 		"template": {
 			Type:        schema.TypeList,
@@ -151,3 +199,79 @@ func jobSpecFields(specUpdatable bool) map[string]*schema.Schema {
 
 	return s
 }
+
+func jobPodFailurePolicyRuleFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"action": {
+			Type:        schema.TypeString,
+			Description: "The action taken on a pod failure matching the rule. `FailJob` fails the job and terminates its pods, `FailIndex` fails the index of the pod, `Ignore` doesn't count the failure against `backoff_limit` and `Count` handles the failure as usual.",
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(batchv1.PodFailurePolicyActionFailJob),
+				string(batchv1.PodFailurePolicyActionFailIndex),
+				string(batchv1.PodFailurePolicyActionIgnore),
+				string(batchv1.PodFailurePolicyActionCount),
+			}, false),
+		},
+		"on_exit_codes": {
+			Type:        schema.TypeList,
+			Description: "Matches the pod when the exit code of one of its containers is in, or not in, the values. Either this or `on_pod_conditions` must be set.",
+			Optional:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"container_name": {
+						Type:        schema.TypeString,
+						Description: "Restricts the rule to the container with this name. When not set, the rule applies to all the containers of the pod.",
+						Optional:    true,
+						ForceNew:    true,
+					},
+					"operator": {
+						Type:        schema.TypeString,
+						Description: "The relationship between the exit code and the values, `In` or `NotIn`.",
+						Required:    true,
+						ForceNew:    true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(batchv1.PodFailurePolicyOnExitCodesOpIn),
+							string(batchv1.PodFailurePolicyOnExitCodesOpNotIn),
+						}, false),
+					},
+					"values": {
+						Type:        schema.TypeList,
+						Description: "The exit codes to check against.",
+						Required:    true,
+						ForceNew:    true,
+						MinItems:    1,
+						Elem:        &schema.Schema{Type: schema.TypeInt},
+					},
+				},
+			},
+		},
+		"on_pod_conditions": {
+			Type:        schema.TypeList,
+			Description: "Matches the pod when it has one of these conditions, e.g. `DisruptionTarget` for a pod evicted or preempted. Either this or `on_exit_codes` must be set.",
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"status": {
+						Type:         schema.TypeString,
+						Description:  "The status of the condition, `True`, `False` or `Unknown`. Defaults to `True`.",
+						Optional:     true,
+						ForceNew:     true,
+						Default:      "True",
+						ValidateFunc: validation.StringInSlice([]string{"True", "False", "Unknown"}, false),
+					},
+					"type": {
+						Type:        schema.TypeString,
+						Description: "The type of the pod condition.",
+						Required:    true,
+						ForceNew:    true,
+					},
+				},
+			},
+		},
+	}
+}
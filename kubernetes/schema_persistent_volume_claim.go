@@ -1,6 +1,8 @@
 package kubernetes
 
 import (
+	api "k8s.io/api/core/v1"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -85,5 +87,138 @@ func persistentVolumeClaimSpecFields() map[string]*schema.Schema {
 			Computed:    true,
 			ForceNew:    true,
 		},
+		"volume_mode": {
+			Type:        schema.TypeString,
+			Description: "Defines what type of volume is required by the claim. Value of Filesystem is implied when not included in claim spec.",
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(api.PersistentVolumeBlock),
+				string(api.PersistentVolumeFilesystem),
+			}, false),
+		},
+		"data_source": {
+			Type:        schema.TypeList,
+			Description: "The source to populate the volume from, either an existing VolumeSnapshot object (`snapshot.storage.k8s.io/VolumeSnapshot`) or an existing PVC. If the provisioner or an external controller can support the specified data source, it will create a new volume based on the contents of the specified data source. Mirrored from `data_source_ref` by the API server when that refers to one of these kinds.",
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"api_group": {
+						Type:        schema.TypeString,
+						Description: "The group for the resource being referenced, e.g. `snapshot.storage.k8s.io`. If not specified, the kind must be in the core API group.",
+						Optional:    true,
+						ForceNew:    true,
+					},
+					"kind": {
+						Type:        schema.TypeString,
+						Description: "The type of resource being referenced, e.g. `VolumeSnapshot` or `PersistentVolumeClaim`.",
+						Required:    true,
+						ForceNew:    true,
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Description: "The name of resource being referenced.",
+						Required:    true,
+						ForceNew:    true,
+					},
+				},
+			},
+		},
+		"data_source_ref": {
+			Type:        schema.TypeList,
+			Description: "The object to populate the volume from. Unlike `data_source`, it may refer to any object of a kind handled by a volume populator, and to an object in another namespace when the CrossNamespaceVolumeDataSource feature is enabled. Mirrored from `data_source` by the API server when only that is set. Requires Kubernetes 1.24 or later. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes/#volume-populators-and-data-sources",
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"api_group": {
+						Type:        schema.TypeString,
+						Description: "The group for the resource being referenced. If not specified, the kind must be in the core API group.",
+						Optional:    true,
+						ForceNew:    true,
+					},
+					"kind": {
+						Type:        schema.TypeString,
+						Description: "The type of resource being referenced.",
+						Required:    true,
+						ForceNew:    true,
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Description: "The name of resource being referenced.",
+						Required:    true,
+						ForceNew:    true,
+					},
+					"namespace": {
+						Type:        schema.TypeString,
+						Description: "The namespace of resource being referenced. If not specified, the namespace of the claim is used. A reference to another namespace must be allowed by a ReferenceGrant in that namespace.",
+						Optional:    true,
+						ForceNew:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func persistentVolumeClaimStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The current status of the claim.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"phase": {
+					Type:        schema.TypeString,
+					Description: "The current phase of the claim, one of Pending, Bound or Lost.",
+					Computed:    true,
+				},
+				"capacity": {
+					Type:        schema.TypeMap,
+					Description: "The actual resources of the underlying volume.",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"conditions": {
+					Type:        schema.TypeList,
+					Description: "The current conditions of the claim, e.g. Resizing or FileSystemResizePending while the volume is expanded.",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"status": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"reason": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"message": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"last_probe_time": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"last_transition_time": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
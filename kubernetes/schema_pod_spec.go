@@ -228,6 +228,51 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			ForceNew:    !isUpdatable,
 			Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: http://kubernetes.io/docs/user-guide/node-selection.",
 		},
+		"os": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    isComputed,
+			ForceNew:    !isUpdatable,
+			MaxItems:    1,
+			Description: "Specifies the OS of the containers in the pod. If set, some pod and container fields are restricted to this OS, and the kubelet rejects the pod when the node runs a different one. More info: https://kubernetes.io/docs/concepts/workloads/pods/#pod-os",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    !isUpdatable,
+						Description: "Name of the operating system. One of linux, windows.",
+						ValidateFunc: validation.StringInSlice([]string{
+							string(api.Linux),
+							string(api.Windows),
+						}, false),
+					},
+				},
+			},
+		},
+		"overhead": {
+			Type:         schema.TypeMap,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     !isUpdatable,
+			ValidateFunc: validateResourceList,
+			Description:  "Overhead represents the resource overhead associated with running a pod for a given RuntimeClass. It is usually set by the RuntimeClass admission controller from the overhead of the runtime class. If set explicitly, it must match the overhead of the runtime class. More info: https://kubernetes.io/docs/concepts/scheduling-eviction/pod-overhead/",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			DiffSuppressFunc: suppressEquivalentResourceQuantity,
+		},
+		"preemption_policy": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    !isUpdatable,
+			Description: "PreemptionPolicy is the policy for preempting pods with lower priority. One of Never, PreemptLowerPriority. Defaults to the preemption policy of the priority class of the pod, or PreemptLowerPriority.",
+			ValidateFunc: validation.StringInSlice([]string{
+				string(api.PreemptLowerPriority),
+				string(api.PreemptNever),
+			}, false),
+		},
 		"priority_class_name": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -248,6 +293,20 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 				string(api.RestartPolicyNever),
 			}, false),
 		},
+		"runtime_class_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    isComputed,
+			ForceNew:    !isUpdatable,
+			Description: "RuntimeClassName is the name of the RuntimeClass object in the node.k8s.io group, which should be used to run this pod. If no RuntimeClass resource matches the named class, the pod will not be run. If unset or empty, the default runtime handler of the node is used. More info: https://kubernetes.io/docs/concepts/containers/runtime-class/",
+		},
+		"scheduler_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    !isUpdatable,
+			Description: "If specified, the pod will be dispatched by the specified scheduler. If not specified, the pod will be dispatched by the default scheduler.",
+		},
 		"security_context": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -292,6 +351,15 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 							Schema: seLinuxOptionsField(isUpdatable),
 						},
 					},
+					"seccomp_profile": {
+						Type:        schema.TypeList,
+						Description: "The seccomp options to use by the containers in this pod. If unspecified, the container runtime default profile is used unless overridden in SecurityContext.",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: seccompProfileField(isUpdatable),
+						},
+					},
 					"supplemental_groups": {
 						Type:        schema.TypeSet,
 						Description: "A list of groups applied to the first process run in each container, in addition to the container's primary GID. If unspecified, no groups will be added to any container.",
@@ -322,6 +390,15 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 							},
 						},
 					},
+					"windows_options": {
+						Type:        schema.TypeList,
+						Description: "The Windows specific settings applied to all containers. If unspecified, the options within a container's SecurityContext will be used. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence.",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: windowsOptionsField(isUpdatable),
+						},
+					},
 				},
 			},
 		},
@@ -332,6 +409,13 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			ForceNew:    !isUpdatable,
 			Description: "ServiceAccountName is the name of the ServiceAccount to use to run this pod. More info: http://releases.k8s.io/HEAD/docs/design/service_accounts.md.",
 		},
+		"set_hostname_as_fqdn": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    !isUpdatable,
+			Description: "If true, the pod's hostname will be configured as the pod's FQDN, rather than the leaf name (the default). In Linux containers, this means setting the FQDN in the hostname field of the kernel. In Windows containers, this means setting the registry value of hostname. Optional: Defaults to false.",
+		},
 		"share_process_namespace": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -458,6 +542,8 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 
 func volumeSchema(isUpdatable bool) *schema.Resource {
 	v := commonVolumeSources()
+	v["csi"] = csiVolumeSourceSchema()
+	v["ephemeral"] = ephemeralVolumeSourceSchema(isUpdatable)
 
 	v["config_map"] = &schema.Schema{
 		Type:        schema.TypeList,
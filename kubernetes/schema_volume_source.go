@@ -613,3 +613,126 @@ func commonVolumeSourcesSecretRef(description string) *schema.Schema {
 		},
 	}
 }
+
+// csiVolumeSourceSchema is the inline CSI volume of pods, as opposed to the
+// csi source of persistent volumes.
+func csiVolumeSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Represents an ephemeral volume provided by a CSI driver, e.g. the Secrets Store CSI driver. More info: https://kubernetes.io/docs/concepts/storage/ephemeral-volumes/#csi-ephemeral-volumes",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"driver": {
+					Type:        schema.TypeString,
+					Description: "Name of the CSI driver that handles this volume. Consult with your admin for the correct name as registered in the cluster.",
+					Required:    true,
+				},
+				"fs_type": {
+					Type:        schema.TypeString,
+					Description: "Filesystem type to mount, e.g. \"ext4\", \"xfs\" or \"ntfs\". If not provided, the CSI driver determines the default filesystem to apply.",
+					Optional:    true,
+				},
+				"node_publish_secret_ref": {
+					Type:        schema.TypeList,
+					Description: "A reference to the secret object containing sensitive information to pass to the CSI driver to complete the CSI NodePublishVolume and NodeUnpublishVolume calls.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Description: "Name of the secret in the pod's namespace. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+								Required:    true,
+							},
+						},
+					},
+				},
+				"read_only": {
+					Type:        schema.TypeBool,
+					Description: "Whether the volume is read-only. Defaults to false (read/write).",
+					Optional:    true,
+				},
+				"volume_attributes": {
+					Type:        schema.TypeMap,
+					Description: "Driver-specific properties that are passed to the CSI driver. Consult your driver's documentation for supported values.",
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// ephemeralVolumeSourceSchema is a generic ephemeral volume, whose claim is
+// created from the template with each pod and deleted with it.
+func ephemeralVolumeSourceSchema(isUpdatable bool) *schema.Schema {
+	spec := persistentVolumeClaimSpecFields()
+	if isUpdatable {
+		// Changing the template of a workload only changes the claims of its new pods
+		unsetForceNew(spec)
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Represents an ephemeral volume that is handled by a normal storage driver. More info: https://kubernetes.io/docs/concepts/storage/ephemeral-volumes/#generic-ephemeral-volumes",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"volume_claim_template": {
+					Type:        schema.TypeList,
+					Description: "Will be used to create a stand-alone PVC to provision the volume. The pod in which this volume is embedded will be the owner of the PVC, i.e. the PVC will be deleted together with the pod. The name of the PVC will be `<pod name>-<volume name>`.",
+					Required:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"metadata": {
+								Type:        schema.TypeList,
+								Description: "May contain labels and annotations that will be copied into the PVC when creating it.",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"annotations": {
+											Type:         schema.TypeMap,
+											Description:  "An unstructured key value map copied into the annotations of the PVC.",
+											Optional:     true,
+											Elem:         &schema.Schema{Type: schema.TypeString},
+											ValidateFunc: validateAnnotations,
+										},
+										"labels": {
+											Type:         schema.TypeMap,
+											Description:  "Map of string keys and values copied into the labels of the PVC.",
+											Optional:     true,
+											Elem:         &schema.Schema{Type: schema.TypeString},
+											ValidateFunc: validateLabels,
+										},
+									},
+								},
+							},
+							"spec": {
+								Type:        schema.TypeList,
+								Description: "The specification for the PersistentVolumeClaim. The entire content is copied unchanged into the PVC that gets created from this template.",
+								Required:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: spec,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func unsetForceNew(m map[string]*schema.Schema) {
+	for _, s := range m {
+		s.ForceNew = false
+		if r, ok := s.Elem.(*schema.Resource); ok {
+			unsetForceNew(r.Schema)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func waitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Wait for the object to reach the given state after it is created or updated. All of the given criteria must be met.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"condition": {
+					Type:        schema.TypeList,
+					Description: "Wait for the object to report a status condition.",
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:        schema.TypeString,
+								Description: "Type of the condition, e.g. `Ready` or `Available`.",
+								Required:    true,
+							},
+							"status": {
+								Type:        schema.TypeString,
+								Description: "Expected status of the condition. Defaults to `True`.",
+								Optional:    true,
+								Default:     "True",
+							},
+						},
+					},
+				},
+				"fields": {
+					Type:        schema.TypeMap,
+					Description: "Map of JSONPath expressions into the object, e.g. `status.phase` or `status.loadBalancer.ingress[0].ip`, to the values they must equal. An empty value waits for the field to be set.",
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"rollout": {
+					Type:        schema.TypeBool,
+					Description: "Wait for the rollout of a deployment, daemon set or stateful set to finish. For other kinds, wait for the controller to observe the latest generation.",
+					Optional:    true,
+					Default:     false,
+				},
+			},
+		},
+	}
+}
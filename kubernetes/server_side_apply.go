@@ -0,0 +1,289 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apiextensionsscheme "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/scheme"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	aggregatorscheme "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset/scheme"
+)
+
+const (
+	applyModeClientSide = "client_side"
+	applyModeServerSide = "server_side"
+
+	defaultFieldManager = "Terraform"
+)
+
+// applyScheme knows the kinds of the objects managed by the resources,
+// which apply patches have to name.
+var applyScheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(scheme.AddToScheme(applyScheme))
+	utilruntime.Must(apiextensionsscheme.AddToScheme(applyScheme))
+	utilruntime.Must(aggregatorscheme.AddToScheme(applyScheme))
+}
+
+// serverSideApplyRoundTripper sets the configured field manager on the
+// server-side apply patches sent by the resources, see createPatch and
+// updatePatch, and explains how to resolve the conflicts they run into.
+type serverSideApplyRoundTripper struct {
+	fieldManager   string
+	forceConflicts bool
+	rt             http.RoundTripper
+}
+
+func newServerSideApplyRoundTripper(fieldManager string, forceConflicts bool, rt http.RoundTripper) http.RoundTripper {
+	return &serverSideApplyRoundTripper{
+		fieldManager:   fieldManager,
+		forceConflicts: forceConflicts,
+		rt:             rt,
+	}
+}
+
+func (t *serverSideApplyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPatch || req.Header.Get("Content-Type") != string(types.ApplyPatchType) {
+		return t.rt.RoundTrip(req)
+	}
+	return t.apply(req.Clone(req.Context()))
+}
+
+// apply sends an apply patch request with the configured field manager,
+// unless the request already names one.
+func (t *serverSideApplyRoundTripper) apply(r *http.Request) (*http.Response, error) {
+	q := r.URL.Query()
+	if q.Get("fieldManager") == "" {
+		q.Set("fieldManager", t.fieldManager)
+	}
+	if t.forceConflicts {
+		q.Set("force", "true")
+	}
+	r.URL.RawQuery = q.Encode()
+
+	resp, err := t.rt.RoundTrip(r)
+	if err != nil || resp.StatusCode != http.StatusConflict {
+		return resp, err
+	}
+	return describeApplyConflict(resp)
+}
+
+// createPatch returns the apply patch creating obj when server-side apply is
+// enabled. Like `kubectl apply --server-side`, the patch also takes over an
+// existing object, as long as the fields it sets aren't managed by someone else.
+// Objects named by the server through `generate_name` are created as usual.
+func createPatch(ctx context.Context, meta interface{}, obj runtime.Object) ([]byte, bool, error) {
+	if !meta.(KubeClientsets).ServerSideApply() {
+		return nil, false, nil
+	}
+	m, err := apimeta.Accessor(obj)
+	if err != nil {
+		return nil, false, err
+	}
+	if m.GetName() == "" {
+		return nil, false, nil
+	}
+	data, err := marshalApplyPatch(ctx, obj)
+	if err != nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// updatePatch returns the patch updating a resource in the configured apply
+// mode: the JSON patch operations with client-side apply, or the apply patch
+// of the object built by expand with server-side apply, so that fields removed
+// from the configuration are released by the field manager.
+func updatePatch(ctx context.Context, meta interface{}, ops PatchOperations, expand func() (runtime.Object, error)) (types.PatchType, []byte, error) {
+	if !meta.(KubeClientsets).ServerSideApply() {
+		data, err := ops.MarshalJSON()
+		return types.JSONPatchType, data, err
+	}
+	obj, err := expand()
+	if err != nil {
+		return "", nil, err
+	}
+	data, err := marshalApplyPatch(ctx, obj)
+	return types.ApplyPatchType, data, err
+}
+
+// applyKeepsDefault lists the attributes which are applied even when their value
+// comes from the schema default, because the API requires them or doesn't default
+// them to the same value.
+var applyKeepsDefault = map[string]bool{
+	"allow_volume_expansion": true,
+	"failure_policy":         true,
+	"match_policy":           true,
+	"max_skew":               true,
+	"namespace":              true,
+	"path_type":              true,
+	"served":                 true,
+	"timeout_seconds":        true,
+	"when_unsatisfiable":     true,
+}
+
+// readOnlyMetadataFields are the metadata fields set by the API server,
+// which an apply patch must not contain.
+var readOnlyMetadataFields = []string{
+	"creationTimestamp",
+	"deletionGracePeriodSeconds",
+	"deletionTimestamp",
+	"generation",
+	"managedFields",
+	"resourceVersion",
+	"selfLink",
+	"uid",
+}
+
+// marshalApplyPatch encodes the object along with its kind and API version
+// as the body of an apply patch. The patch only has the fields set in the
+// configuration: status, read-only metadata, null fields and the values of
+// the attributes left to their schema default are left out, so that Terraform
+// doesn't own them.
+func marshalApplyPatch(ctx context.Context, obj runtime.Object) ([]byte, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	if obj.GetObjectKind().GroupVersionKind().Empty() {
+		gvks, _, err := applyScheme.ObjectKinds(obj)
+		if err != nil {
+			return nil, err
+		}
+		u["apiVersion"], u["kind"] = gvks[0].ToAPIVersionAndKind()
+	}
+
+	delete(u, "status")
+	if m, ok := u["metadata"].(map[string]interface{}); ok {
+		for _, f := range readOnlyMetadataFields {
+			delete(m, f)
+		}
+	}
+	if attrs, ok := ctx.Value(defaultedAttributesKey{}).([]defaultedAttribute); ok {
+		for _, a := range attrs {
+			removeDefaultedField(u, a.Path, a.Value)
+		}
+	}
+	removeNullFields(u)
+	return json.Marshal(u)
+}
+
+// removeDefaultedField removes the field of the object matching the path of a
+// resource attribute, if it has the default value of the attribute. Attributes
+// are matched to fields by name, e.g. `init_container` to `initContainers`, and
+// the blocks of a single item to objects.
+func removeDefaultedField(obj map[string]interface{}, path []interface{}, value interface{}) {
+	var cur interface{} = obj
+	for i, step := range path {
+		switch step := step.(type) {
+		case string:
+			m, ok := cur.(map[string]interface{})
+			if !ok {
+				return
+			}
+			k, ok := applyFieldKey(m, step)
+			if !ok {
+				return
+			}
+			if i == len(path)-1 {
+				if fmt.Sprint(m[k]) == fmt.Sprint(value) {
+					delete(m, k)
+				}
+				return
+			}
+			cur = m[k]
+		case int:
+			switch v := cur.(type) {
+			case []interface{}:
+				if step >= len(v) {
+					return
+				}
+				cur = v[step]
+			case map[string]interface{}:
+				if step != 0 {
+					return
+				}
+			default:
+				return
+			}
+		}
+	}
+}
+
+// applyFieldKey returns the key of the object field matching an attribute name.
+func applyFieldKey(obj map[string]interface{}, attr string) (string, bool) {
+	keys := make(map[string]string, len(obj))
+	for k := range obj {
+		keys[strings.ToLower(k)] = k
+	}
+	name := strings.ToLower(strings.ReplaceAll(attr, "_", ""))
+	for _, n := range []string{name, name + "s", name + "es", strings.TrimSuffix(name, "y") + "ies"} {
+		if k, ok := keys[n]; ok {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// removeNullFields removes the fields without value from the object.
+func removeNullFields(obj map[string]interface{}) {
+	for k, v := range obj {
+		switch v := v.(type) {
+		case nil:
+			delete(obj, k)
+		case map[string]interface{}:
+			removeNullFields(v)
+		case []interface{}:
+			for _, e := range v {
+				if m, ok := e.(map[string]interface{}); ok {
+					removeNullFields(m)
+				}
+			}
+		}
+	}
+}
+
+// describeApplyConflict extends the message of a conflict status returned
+// by the API server with a hint on how to take ownership of the fields.
+func describeApplyConflict(resp *http.Response) (*http.Response, error) {
+	if !isJSONContentType(resp.Header.Get("Content-Type")) {
+		return resp, nil
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	status := metav1.Status{}
+	if err := json.Unmarshal(body, &status); err == nil && status.Kind == "Status" {
+		status.Message = fmt.Sprintf("%s. These fields are managed by another field manager; "+
+			"set `force_conflicts = true` in the provider configuration to take ownership of them", strings.TrimSuffix(status.Message, "."))
+		if out, err := json.Marshal(status); err == nil {
+			body = out
+		}
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	return resp, nil
+}
+
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "application/json"
+}
@@ -0,0 +1,218 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	api "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+)
+
+func TestServerSideApplyRoundTripper(t *testing.T) {
+	var method, path, contentType, fieldManager, force string
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		contentType = r.Header.Get("Content-Type")
+		fieldManager = r.URL.Query().Get("fieldManager")
+		force = r.URL.Query().Get("force")
+		body, _ = ioutil.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/conflicting") {
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode(metav1.Status{
+				TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+				Status:   metav1.StatusFailure,
+				Reason:   metav1.StatusReasonConflict,
+				Code:     http.StatusConflict,
+				Message:  `Apply failed with 1 conflict: conflict with "kubectl" using v1: .data.foo`,
+			})
+			return
+		}
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	cfg := &restclient.Config{Host: server.URL}
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return newServerSideApplyRoundTripper("test-manager", true, rt)
+	})
+	conn, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.TODO()
+
+	cm := &api.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Data:       map[string]string{"foo": "bar"},
+	}
+	_, err = conn.CoreV1().ConfigMaps("default").Create(ctx, cm, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || path != "/api/v1/namespaces/default/configmaps" || fieldManager != "" {
+		t.Fatalf("Expected the create request to be left as is, got %s %s with field manager %q", method, path, fieldManager)
+	}
+
+	data, err := marshalApplyPatch(ctx, cm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := conn.CoreV1().ConfigMaps("default").Patch(ctx, "foo", types.ApplyPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPatch || path != "/api/v1/namespaces/default/configmaps/foo" {
+		t.Fatalf("Expected an apply patch of the config map, got %s %s", method, path)
+	}
+	if contentType != string(types.ApplyPatchType) || fieldManager != "test-manager" || force != "true" {
+		t.Fatalf("Unexpected apply patch: content type %q, field manager %q, force %q", contentType, fieldManager, force)
+	}
+	if out.Data["foo"] != "bar" {
+		t.Fatalf("Unexpected response: %#v", out)
+	}
+
+	_, err = conn.CoreV1().ConfigMaps("default").Patch(ctx, "foo", types.ApplyPatchType, data, metav1.PatchOptions{FieldManager: "other-manager"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fieldManager != "other-manager" {
+		t.Fatalf("Expected the field manager of the request to be kept, got %q", fieldManager)
+	}
+
+	_, err = conn.CoreV1().ConfigMaps("default").Patch(ctx, "conflicting", types.ApplyPatchType, data, metav1.PatchOptions{})
+	if !errors.IsConflict(err) {
+		t.Fatalf("Expected a conflict error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), ".data.foo") || !strings.Contains(err.Error(), "force_conflicts") {
+		t.Fatalf("Expected the conflict to be described, got %q", err)
+	}
+}
+
+func TestCreatePatch(t *testing.T) {
+	ctx := context.TODO()
+	cm := &api.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Data:       map[string]string{"foo": "bar"},
+	}
+
+	_, apply, err := createPatch(ctx, &kubeClientsets{}, cm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if apply {
+		t.Fatal("Expected objects to be created with client-side apply")
+	}
+
+	data, apply, err := createPatch(ctx, &kubeClientsets{serverSideApply: true}, cm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !apply || !bytes.Contains(data, []byte(`"kind":"ConfigMap"`)) {
+		t.Fatalf("Expected an apply patch of the config map, got %s", data)
+	}
+
+	cm.Name = ""
+	cm.GenerateName = "foo-"
+	_, apply, err = createPatch(ctx, &kubeClientsets{serverSideApply: true}, cm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if apply {
+		t.Fatal("Expected objects named by the server to be created as usual")
+	}
+}
+
+func TestMarshalApplyPatch(t *testing.T) {
+	testCases := []struct {
+		Object     runtime.Object
+		APIVersion string
+		Kind       string
+	}{
+		{&api.ConfigMap{}, "v1", "ConfigMap"},
+		{&appsv1.Deployment{}, "apps/v1", "Deployment"},
+		{&apiextensionsv1.CustomResourceDefinition{}, "apiextensions.k8s.io/v1", "CustomResourceDefinition"},
+		{&apiregistrationv1.APIService{}, "apiregistration.k8s.io/v1", "APIService"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Kind, func(t *testing.T) {
+			data, err := marshalApplyPatch(context.TODO(), tc.Object)
+			if err != nil {
+				t.Fatal(err)
+			}
+			obj := metav1.TypeMeta{}
+			if err := json.Unmarshal(data, &obj); err != nil {
+				t.Fatal(err)
+			}
+			if obj.APIVersion != tc.APIVersion || obj.Kind != tc.Kind {
+				t.Fatalf("Expected %s %s, got %s %s", tc.APIVersion, tc.Kind, obj.APIVersion, obj.Kind)
+			}
+		})
+	}
+}
+
+func TestMarshalApplyPatch_configuredFields(t *testing.T) {
+	pod := &api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "foo",
+			Namespace:       "default",
+			ResourceVersion: "42",
+			UID:             "2f4a3e2c",
+		},
+		Spec: api.PodSpec{
+			Containers:    []api.Container{{Name: "app", Image: "nginx"}},
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+		},
+	}
+	ctx := context.WithValue(context.TODO(), defaultedAttributesKey{}, []defaultedAttribute{
+		{Path: []interface{}{"spec", 0, "restart_policy"}, Value: "Always"},
+		{Path: []interface{}{"spec", 0, "dns_policy"}, Value: "Default"},
+		{Path: []interface{}{"spec", 0, "container", 0, "image"}, Value: "nginx"},
+		{Path: []interface{}{"spec", 0, "container", 1, "image"}, Value: "nginx"},
+	})
+	data, err := marshalApplyPatch(ctx, pod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := map[string]interface{}{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":      "foo",
+			"namespace": "default",
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{map[string]interface{}{
+				"name":      "app",
+				"resources": map[string]interface{}{},
+			}},
+			"dnsPolicy": "ClusterFirst",
+		},
+	}
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Expected %#v, got %s", expected, data)
+	}
+}
@@ -1,11 +1,18 @@
 package kubernetes
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/api/batch/v1beta1"
 )
 
-func flattenCronJobSpec(in v1beta1.CronJobSpec, d *schema.ResourceData) ([]interface{}, error) {
+// Cron jobs are modelled on batch/v1 and converted from and to batch/v1beta1
+// for clusters older than 1.21, which don't serve batch/v1 cron jobs yet.
+// batch/v1beta1 has no time zone, so a cron job setting one is refused there.
+
+func flattenCronJobSpec(in batchv1.CronJobSpec, d *schema.ResourceData) ([]interface{}, error) {
 	att := make(map[string]interface{})
 
 	att["concurrency_policy"] = in.ConcurrencyPolicy
@@ -30,12 +37,18 @@ func flattenCronJobSpec(in v1beta1.CronJobSpec, d *schema.ResourceData) ([]inter
 		att["successful_jobs_history_limit"] = int(*in.SuccessfulJobsHistoryLimit)
 	}
 
-	att["suspend"] = in.Suspend
+	if in.Suspend != nil {
+		att["suspend"] = *in.Suspend
+	}
+
+	if in.TimeZone != nil {
+		att["time_zone"] = *in.TimeZone
+	}
 
 	return []interface{}{att}, nil
 }
 
-func flattenJobTemplate(in v1beta1.JobTemplateSpec, d *schema.ResourceData) ([]interface{}, error) {
+func flattenJobTemplate(in batchv1.JobTemplateSpec, d *schema.ResourceData) ([]interface{}, error) {
 	att := make(map[string]interface{})
 
 	meta := flattenMetadata(in.ObjectMeta, d)
@@ -50,8 +63,28 @@ func flattenJobTemplate(in v1beta1.JobTemplateSpec, d *schema.ResourceData) ([]i
 	return []interface{}{att}, nil
 }
 
-func expandCronJobSpec(j []interface{}) (v1beta1.CronJobSpec, error) {
-	obj := v1beta1.CronJobSpec{}
+func flattenCronJobStatus(in batchv1.CronJobStatus) []interface{} {
+	att := make(map[string]interface{})
+
+	if in.LastScheduleTime != nil {
+		att["last_schedule_time"] = in.LastScheduleTime.Format(time.RFC3339)
+	}
+
+	if in.LastSuccessfulTime != nil {
+		att["last_successful_time"] = in.LastSuccessfulTime.Format(time.RFC3339)
+	}
+
+	active := make([]interface{}, len(in.Active))
+	for i, ref := range in.Active {
+		active[i] = ref.Name
+	}
+	att["active"] = active
+
+	return []interface{}{att}
+}
+
+func expandCronJobSpec(j []interface{}) (batchv1.CronJobSpec, error) {
+	obj := batchv1.CronJobSpec{}
 
 	if len(j) == 0 || j[0] == nil {
 		return obj, nil
@@ -60,7 +93,7 @@ func expandCronJobSpec(j []interface{}) (v1beta1.CronJobSpec, error) {
 	in := j[0].(map[string]interface{})
 
 	if v, ok := in["concurrency_policy"].(string); ok && v != "" {
-		obj.ConcurrencyPolicy = v1beta1.ConcurrencyPolicy(v)
+		obj.ConcurrencyPolicy = batchv1.ConcurrencyPolicy(v)
 	}
 
 	if v, ok := in["failed_jobs_history_limit"].(int); ok && v != 1 {
@@ -89,11 +122,15 @@ func expandCronJobSpec(j []interface{}) (v1beta1.CronJobSpec, error) {
 		obj.Suspend = ptrToBool(v)
 	}
 
+	if v, ok := in["time_zone"].(string); ok && v != "" {
+		obj.TimeZone = ptrToString(v)
+	}
+
 	return obj, nil
 }
 
-func expandJobTemplate(in []interface{}) (v1beta1.JobTemplateSpec, error) {
-	obj := v1beta1.JobTemplateSpec{}
+func expandJobTemplate(in []interface{}) (batchv1.JobTemplateSpec, error) {
+	obj := batchv1.JobTemplateSpec{}
 
 	if len(in) == 0 || in[0] == nil {
 		return obj, nil
@@ -114,3 +151,44 @@ func expandJobTemplate(in []interface{}) (v1beta1.JobTemplateSpec, error) {
 
 	return obj, nil
 }
+
+func convertCronJobToV1beta1(in *batchv1.CronJob) *v1beta1.CronJob {
+	return &v1beta1.CronJob{
+		ObjectMeta: in.ObjectMeta,
+		Spec: v1beta1.CronJobSpec{
+			Schedule:                in.Spec.Schedule,
+			StartingDeadlineSeconds: in.Spec.StartingDeadlineSeconds,
+			ConcurrencyPolicy:       v1beta1.ConcurrencyPolicy(in.Spec.ConcurrencyPolicy),
+			Suspend:                 in.Spec.Suspend,
+			JobTemplate: v1beta1.JobTemplateSpec{
+				ObjectMeta: in.Spec.JobTemplate.ObjectMeta,
+				Spec:       in.Spec.JobTemplate.Spec,
+			},
+			SuccessfulJobsHistoryLimit: in.Spec.SuccessfulJobsHistoryLimit,
+			FailedJobsHistoryLimit:     in.Spec.FailedJobsHistoryLimit,
+		},
+	}
+}
+
+func convertCronJobFromV1beta1(in *v1beta1.CronJob) *batchv1.CronJob {
+	return &batchv1.CronJob{
+		ObjectMeta: in.ObjectMeta,
+		Spec: batchv1.CronJobSpec{
+			Schedule:                in.Spec.Schedule,
+			StartingDeadlineSeconds: in.Spec.StartingDeadlineSeconds,
+			ConcurrencyPolicy:       batchv1.ConcurrencyPolicy(in.Spec.ConcurrencyPolicy),
+			Suspend:                 in.Spec.Suspend,
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: in.Spec.JobTemplate.ObjectMeta,
+				Spec:       in.Spec.JobTemplate.Spec,
+			},
+			SuccessfulJobsHistoryLimit: in.Spec.SuccessfulJobsHistoryLimit,
+			FailedJobsHistoryLimit:     in.Spec.FailedJobsHistoryLimit,
+		},
+		Status: batchv1.CronJobStatus{
+			Active:             in.Status.Active,
+			LastScheduleTime:   in.Status.LastScheduleTime,
+			LastSuccessfulTime: in.Status.LastSuccessfulTime,
+		},
+	}
+}
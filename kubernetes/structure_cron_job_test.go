@@ -0,0 +1,99 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConvertCronJobV1beta1RoundTrip(t *testing.T) {
+	lastSchedule := metav1.NewTime(time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC))
+	in := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup",
+			Namespace: "default",
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule:                   "*/5 * * * *",
+			StartingDeadlineSeconds:    ptrToInt64(30),
+			ConcurrencyPolicy:          batchv1.ForbidConcurrent,
+			Suspend:                    ptrToBool(true),
+			SuccessfulJobsHistoryLimit: ptrToInt32(5),
+			FailedJobsHistoryLimit:     ptrToInt32(2),
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": "backup"},
+				},
+				Spec: batchv1.JobSpec{
+					BackoffLimit: ptrToInt32(3),
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							RestartPolicy: v1.RestartPolicyNever,
+							Containers:    []v1.Container{{Name: "backup", Image: "alpine"}},
+						},
+					},
+				},
+			},
+		},
+		Status: batchv1.CronJobStatus{
+			Active:           []v1.ObjectReference{{Name: "backup-1620000000"}},
+			LastScheduleTime: &lastSchedule,
+		},
+	}
+
+	out := convertCronJobFromV1beta1(convertCronJobToV1beta1(in))
+	// Status is never sent to the API server
+	out.Status = in.Status
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("Round trip mismatch.\nExpected: %#v\nGot:      %#v", in, out)
+	}
+}
+
+func TestFlattenCronJobStatus(t *testing.T) {
+	lastSchedule := metav1.NewTime(time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC))
+	in := batchv1.CronJobStatus{
+		Active:           []v1.ObjectReference{{Name: "backup-1620000000"}},
+		LastScheduleTime: &lastSchedule,
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"active":             []interface{}{"backup-1620000000"},
+			"last_schedule_time": "2021-05-01T10:00:00Z",
+		},
+	}
+
+	out := flattenCronJobStatus(in)
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Unexpected status.\nExpected: %#v\nGot:      %#v", expected, out)
+	}
+}
+
+func TestExpandCronJobSpecTimeZone(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"schedule":     "1 0 * * *",
+			"time_zone":    "Etc/UTC",
+			"job_template": []interface{}{},
+		},
+	}
+	out, err := expandCronJobSpec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.TimeZone == nil || *out.TimeZone != "Etc/UTC" {
+		t.Fatalf("Expected time zone Etc/UTC, got %v", out.TimeZone)
+	}
+
+	in[0].(map[string]interface{})["time_zone"] = ""
+	out, err = expandCronJobSpec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.TimeZone != nil {
+		t.Fatalf("Expected no time zone, got %q", *out.TimeZone)
+	}
+}
@@ -2,13 +2,15 @@ package kubernetes
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"k8s.io/api/extensions/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/api/networking/v1beta1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // Flatteners
 
-func flattenIngressRule(in []v1beta1.IngressRule) []interface{} {
+func flattenIngressRule(in []networking.IngressRule) []interface{} {
 	att := make([]interface{}, len(in), len(in))
 	for i, r := range in {
 		m := make(map[string]interface{})
@@ -20,7 +22,7 @@ func flattenIngressRule(in []v1beta1.IngressRule) []interface{} {
 	return att
 }
 
-func flattenIngressRuleHttp(in *v1beta1.HTTPIngressRuleValue) []interface{} {
+func flattenIngressRuleHttp(in *networking.HTTPIngressRuleValue) []interface{} {
 	if in == nil {
 		return []interface{}{}
 	}
@@ -30,6 +32,9 @@ func flattenIngressRuleHttp(in *v1beta1.HTTPIngressRuleValue) []interface{} {
 			"path":    p.Path,
 			"backend": flattenIngressBackend(&p.Backend),
 		}
+		if p.PathType != nil {
+			path["path_type"] = string(*p.PathType)
+		}
 		pathAtts[i] = path
 	}
 
@@ -40,27 +45,48 @@ func flattenIngressRuleHttp(in *v1beta1.HTTPIngressRuleValue) []interface{} {
 	return []interface{}{httpAtt}
 }
 
-func flattenIngressBackend(in *v1beta1.IngressBackend) []interface{} {
+func flattenIngressBackend(in *networking.IngressBackend) []interface{} {
 	att := make([]interface{}, 1, 1)
 
 	m := make(map[string]interface{})
-	m["service_name"] = in.ServiceName
-	m["service_port"] = in.ServicePort.String()
+	if in.Service != nil {
+		m["service"] = []interface{}{
+			map[string]interface{}{
+				"name": in.Service.Name,
+				"port": []interface{}{
+					map[string]interface{}{
+						"name":   in.Service.Port.Name,
+						"number": int(in.Service.Port.Number),
+					},
+				},
+			},
+		}
+	}
+	if in.Resource != nil {
+		r := map[string]interface{}{
+			"kind": in.Resource.Kind,
+			"name": in.Resource.Name,
+		}
+		if in.Resource.APIGroup != nil {
+			r["api_group"] = *in.Resource.APIGroup
+		}
+		m["resource"] = []interface{}{r}
+	}
 
 	att[0] = m
 
 	return att
 }
 
-func flattenIngressSpec(in v1beta1.IngressSpec) []interface{} {
+func flattenIngressSpec(in networking.IngressSpec) []interface{} {
 	att := make(map[string]interface{})
 
 	if in.IngressClassName != nil {
 		att["ingress_class_name"] = in.IngressClassName
 	}
 
-	if in.Backend != nil {
-		att["backend"] = flattenIngressBackend(in.Backend)
+	if in.DefaultBackend != nil {
+		att["default_backend"] = flattenIngressBackend(in.DefaultBackend)
 	}
 
 	if len(in.Rules) > 0 {
@@ -74,7 +100,7 @@ func flattenIngressSpec(in v1beta1.IngressSpec) []interface{} {
 	return []interface{}{att}
 }
 
-func flattenIngressTLS(in []v1beta1.IngressTLS) []interface{} {
+func flattenIngressTLS(in []networking.IngressTLS) []interface{} {
 	att := make([]interface{}, len(in), len(in))
 
 	for i, v := range in {
@@ -90,15 +116,15 @@ func flattenIngressTLS(in []v1beta1.IngressTLS) []interface{} {
 
 // Expanders
 
-func expandIngressRule(l []interface{}) []v1beta1.IngressRule {
+func expandIngressRule(l []interface{}) []networking.IngressRule {
 	if len(l) == 0 || l[0] == nil {
-		return []v1beta1.IngressRule{}
+		return []networking.IngressRule{}
 	}
-	obj := make([]v1beta1.IngressRule, len(l), len(l))
+	obj := make([]networking.IngressRule, len(l), len(l))
 	for i, n := range l {
 		cfg := n.(map[string]interface{})
 
-		var paths []v1beta1.HTTPIngressPath
+		var paths []networking.HTTPIngressPath
 
 		if httpCfg, ok := cfg["http"]; ok {
 			httpList := httpCfg.([]interface{})
@@ -106,23 +132,27 @@ func expandIngressRule(l []interface{}) []v1beta1.IngressRule {
 				http := h.(map[string]interface{})
 				if v, ok := http["path"]; ok {
 					pathList := v.([]interface{})
-					paths = make([]v1beta1.HTTPIngressPath, len(pathList), len(pathList))
+					paths = make([]networking.HTTPIngressPath, len(pathList), len(pathList))
 					for i, path := range pathList {
 						p := path.(map[string]interface{})
-						hip := v1beta1.HTTPIngressPath{
+						hip := networking.HTTPIngressPath{
 							Path:    p["path"].(string),
 							Backend: *expandIngressBackend(p["backend"].([]interface{})),
 						}
+						if v, ok := p["path_type"].(string); ok && v != "" {
+							pathType := networking.PathType(v)
+							hip.PathType = &pathType
+						}
 						paths[i] = hip
 					}
 				}
 			}
 		}
 
-		obj[i] = v1beta1.IngressRule{
+		obj[i] = networking.IngressRule{
 			Host: cfg["host"].(string),
-			IngressRuleValue: v1beta1.IngressRuleValue{
-				HTTP: &v1beta1.HTTPIngressRuleValue{
+			IngressRuleValue: networking.IngressRuleValue{
+				HTTP: &networking.HTTPIngressRuleValue{
 					Paths: paths,
 				},
 			},
@@ -131,19 +161,19 @@ func expandIngressRule(l []interface{}) []v1beta1.IngressRule {
 	return obj
 }
 
-func expandIngressSpec(l []interface{}) v1beta1.IngressSpec {
+func expandIngressSpec(l []interface{}) networking.IngressSpec {
 	if len(l) == 0 || l[0] == nil {
-		return v1beta1.IngressSpec{}
+		return networking.IngressSpec{}
 	}
 	in := l[0].(map[string]interface{})
-	obj := v1beta1.IngressSpec{}
+	obj := networking.IngressSpec{}
 
 	if v, ok := in["ingress_class_name"].(string); ok && len(v) > 0 {
 		obj.IngressClassName = &v
 	}
 
-	if v, ok := in["backend"].([]interface{}); ok && len(v) > 0 {
-		obj.Backend = expandIngressBackend(v)
+	if v, ok := in["default_backend"].([]interface{}); ok && len(v) > 0 {
+		obj.DefaultBackend = expandIngressBackend(v)
 	}
 
 	if v, ok := in["rule"].([]interface{}); ok && len(v) > 0 {
@@ -157,33 +187,52 @@ func expandIngressSpec(l []interface{}) v1beta1.IngressSpec {
 	return obj
 }
 
-func expandIngressBackend(l []interface{}) *v1beta1.IngressBackend {
+func expandIngressBackend(l []interface{}) *networking.IngressBackend {
 	if len(l) == 0 || l[0] == nil {
-		return &v1beta1.IngressBackend{}
+		return &networking.IngressBackend{}
 	}
 	in := l[0].(map[string]interface{})
-	obj := &v1beta1.IngressBackend{}
+	obj := &networking.IngressBackend{}
 
-	if v, ok := in["service_name"].(string); ok {
-		obj.ServiceName = v
+	if v, ok := in["service"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		svc := v[0].(map[string]interface{})
+		obj.Service = &networking.IngressServiceBackend{
+			Name: svc["name"].(string),
+		}
+		if p, ok := svc["port"].([]interface{}); ok && len(p) > 0 && p[0] != nil {
+			port := p[0].(map[string]interface{})
+			if v, ok := port["name"].(string); ok {
+				obj.Service.Port.Name = v
+			}
+			if v, ok := port["number"].(int); ok {
+				obj.Service.Port.Number = int32(v)
+			}
+		}
 	}
 
-	if v, ok := in["service_port"].(string); ok {
-		obj.ServicePort = intstr.Parse(v)
+	if v, ok := in["resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		r := v[0].(map[string]interface{})
+		obj.Resource = &v1.TypedLocalObjectReference{
+			Kind: r["kind"].(string),
+			Name: r["name"].(string),
+		}
+		if v, ok := r["api_group"].(string); ok && v != "" {
+			obj.Resource.APIGroup = &v
+		}
 	}
 
 	return obj
 }
 
-func expandIngressTLS(l []interface{}) []v1beta1.IngressTLS {
+func expandIngressTLS(l []interface{}) []networking.IngressTLS {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
 
-	tlsList := make([]v1beta1.IngressTLS, len(l), len(l))
+	tlsList := make([]networking.IngressTLS, len(l), len(l))
 	for i, t := range l {
 		in := t.(map[string]interface{})
-		obj := v1beta1.IngressTLS{}
+		obj := networking.IngressTLS{}
 
 		if v, ok := in["hosts"]; ok {
 			obj.Hosts = expandStringSlice(v.([]interface{}))
@@ -202,10 +251,10 @@ func expandIngressTLS(l []interface{}) []v1beta1.IngressTLS {
 
 func patchIngressSpec(keyPrefix, pathPrefix string, d *schema.ResourceData) PatchOperations {
 	ops := make([]PatchOperation, 0, 0)
-	if d.HasChange(keyPrefix + "backend") {
+	if d.HasChange(keyPrefix + "default_backend") {
 		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "backend",
-			Value: expandIngressBackend(d.Get(keyPrefix + "backend").([]interface{})),
+			Path:  pathPrefix + "defaultBackend",
+			Value: expandIngressBackend(d.Get(keyPrefix + "default_backend").([]interface{})),
 		})
 	}
 
@@ -225,3 +274,147 @@ func patchIngressSpec(keyPrefix, pathPrefix string, d *schema.ResourceData) Patc
 
 	return ops
 }
+
+// flattenIngressLoadBalancerStatus flattens the load balancer status of an
+// ingress the same way as the one of a service.
+func flattenIngressLoadBalancerStatus(in networking.IngressLoadBalancerStatus) []interface{} {
+	lb := v1.LoadBalancerStatus{}
+	for _, ingress := range in.Ingress {
+		lb.Ingress = append(lb.Ingress, v1.LoadBalancerIngress{
+			IP:       ingress.IP,
+			Hostname: ingress.Hostname,
+		})
+	}
+	return flattenLoadBalancerStatus(lb)
+}
+
+// Conversions between networking.k8s.io/v1 and networking.k8s.io/v1beta1,
+// used on clusters which don't serve ingresses from networking.k8s.io/v1
+
+func convertIngressToV1beta1(in *networking.Ingress) *v1beta1.Ingress {
+	out := &v1beta1.Ingress{
+		ObjectMeta: in.ObjectMeta,
+		Spec: v1beta1.IngressSpec{
+			IngressClassName: in.Spec.IngressClassName,
+		},
+		Status: v1beta1.IngressStatus{
+			LoadBalancer: v1beta1.IngressLoadBalancerStatus{
+				Ingress: make([]v1beta1.IngressLoadBalancerIngress, len(in.Status.LoadBalancer.Ingress)),
+			},
+		},
+	}
+	for i, lb := range in.Status.LoadBalancer.Ingress {
+		out.Status.LoadBalancer.Ingress[i] = v1beta1.IngressLoadBalancerIngress{
+			IP:       lb.IP,
+			Hostname: lb.Hostname,
+		}
+	}
+	if in.Spec.DefaultBackend != nil {
+		out.Spec.Backend = convertIngressBackendToV1beta1(*in.Spec.DefaultBackend)
+	}
+	for _, r := range in.Spec.Rules {
+		rule := v1beta1.IngressRule{Host: r.Host}
+		if r.HTTP != nil {
+			rule.HTTP = &v1beta1.HTTPIngressRuleValue{}
+			for _, p := range r.HTTP.Paths {
+				path := v1beta1.HTTPIngressPath{
+					Path:    p.Path,
+					Backend: *convertIngressBackendToV1beta1(p.Backend),
+				}
+				if p.PathType != nil {
+					pathType := v1beta1.PathType(*p.PathType)
+					path.PathType = &pathType
+				}
+				rule.HTTP.Paths = append(rule.HTTP.Paths, path)
+			}
+		}
+		out.Spec.Rules = append(out.Spec.Rules, rule)
+	}
+	for _, t := range in.Spec.TLS {
+		out.Spec.TLS = append(out.Spec.TLS, v1beta1.IngressTLS{
+			Hosts:      t.Hosts,
+			SecretName: t.SecretName,
+		})
+	}
+	return out
+}
+
+func convertIngressBackendToV1beta1(in networking.IngressBackend) *v1beta1.IngressBackend {
+	out := &v1beta1.IngressBackend{
+		Resource: in.Resource,
+	}
+	if in.Service != nil {
+		out.ServiceName = in.Service.Name
+		if in.Service.Port.Name != "" {
+			out.ServicePort = intstr.FromString(in.Service.Port.Name)
+		} else {
+			out.ServicePort = intstr.FromInt(int(in.Service.Port.Number))
+		}
+	}
+	return out
+}
+
+func convertIngressFromV1beta1(in *v1beta1.Ingress) *networking.Ingress {
+	out := &networking.Ingress{
+		ObjectMeta: in.ObjectMeta,
+		Spec: networking.IngressSpec{
+			IngressClassName: in.Spec.IngressClassName,
+		},
+		Status: networking.IngressStatus{
+			LoadBalancer: networking.IngressLoadBalancerStatus{
+				Ingress: make([]networking.IngressLoadBalancerIngress, len(in.Status.LoadBalancer.Ingress)),
+			},
+		},
+	}
+	for i, lb := range in.Status.LoadBalancer.Ingress {
+		out.Status.LoadBalancer.Ingress[i] = networking.IngressLoadBalancerIngress{
+			IP:       lb.IP,
+			Hostname: lb.Hostname,
+		}
+	}
+	if in.Spec.Backend != nil {
+		out.Spec.DefaultBackend = convertIngressBackendFromV1beta1(*in.Spec.Backend)
+	}
+	for _, r := range in.Spec.Rules {
+		rule := networking.IngressRule{Host: r.Host}
+		if r.HTTP != nil {
+			rule.HTTP = &networking.HTTPIngressRuleValue{}
+			for _, p := range r.HTTP.Paths {
+				path := networking.HTTPIngressPath{
+					Path:    p.Path,
+					Backend: *convertIngressBackendFromV1beta1(p.Backend),
+				}
+				if p.PathType != nil {
+					pathType := networking.PathType(*p.PathType)
+					path.PathType = &pathType
+				}
+				rule.HTTP.Paths = append(rule.HTTP.Paths, path)
+			}
+		}
+		out.Spec.Rules = append(out.Spec.Rules, rule)
+	}
+	for _, t := range in.Spec.TLS {
+		out.Spec.TLS = append(out.Spec.TLS, networking.IngressTLS{
+			Hosts:      t.Hosts,
+			SecretName: t.SecretName,
+		})
+	}
+	return out
+}
+
+func convertIngressBackendFromV1beta1(in v1beta1.IngressBackend) *networking.IngressBackend {
+	out := &networking.IngressBackend{
+		Resource: in.Resource,
+	}
+	if in.ServiceName != "" {
+		out.Service = &networking.IngressServiceBackend{
+			Name: in.ServiceName,
+		}
+		if in.ServicePort.Type == intstr.String {
+			out.Service.Port.Name = in.ServicePort.StrVal
+		} else {
+			out.Service.Port.Number = in.ServicePort.IntVal
+		}
+	}
+	return out
+}
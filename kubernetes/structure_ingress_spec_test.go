@@ -1,38 +1,44 @@
 package kubernetes
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
-	"k8s.io/api/extensions/v1beta1"
-	"k8s.io/apimachinery/pkg/util/intstr"
+	networking "k8s.io/api/networking/v1"
 )
 
 // Test Flatteners
 func TestFlattenIngressRule(t *testing.T) {
-	r := v1beta1.HTTPIngressRuleValue{
-		Paths: []v1beta1.HTTPIngressPath{
+	pathType := networking.PathTypePrefix
+	r := networking.HTTPIngressRuleValue{
+		Paths: []networking.HTTPIngressPath{
 			{
-				Path: "/foo/bar",
-				Backend: v1beta1.IngressBackend{
-					ServiceName: "foo",
-					ServicePort: intstr.FromInt(1234),
+				Path:     "/foo/bar",
+				PathType: &pathType,
+				Backend: networking.IngressBackend{
+					Service: &networking.IngressServiceBackend{
+						Name: "foo",
+						Port: networking.ServiceBackendPort{
+							Number: 1234,
+						},
+					},
 				},
 			},
 		},
 	}
 
-	in := []v1beta1.IngressRule{
+	in := []networking.IngressRule{
 		{
 			Host: "the-app-name.staging.live.domain-replaced.tld",
-			IngressRuleValue: v1beta1.IngressRuleValue{
-				HTTP: (*v1beta1.HTTPIngressRuleValue)(nil),
+			IngressRuleValue: networking.IngressRuleValue{
+				HTTP: (*networking.HTTPIngressRuleValue)(nil),
 			},
 		},
 		{
 			Host: "",
-			IngressRuleValue: v1beta1.IngressRuleValue{
-				HTTP: (*v1beta1.HTTPIngressRuleValue)(&r),
+			IngressRuleValue: networking.IngressRuleValue{
+				HTTP: (*networking.HTTPIngressRuleValue)(&r),
 			},
 		},
 	}
@@ -47,11 +53,21 @@ func TestFlattenIngressRule(t *testing.T) {
 				map[string]interface{}{
 					"path": []interface{}{
 						map[string]interface{}{
-							"path": "/foo/bar",
+							"path":      "/foo/bar",
+							"path_type": "Prefix",
 							"backend": []interface{}{
 								map[string]interface{}{
-									"service_name": "foo",
-									"service_port": "1234",
+									"service": []interface{}{
+										map[string]interface{}{
+											"name": "foo",
+											"port": []interface{}{
+												map[string]interface{}{
+													"name":   "",
+													"number": 1234,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -72,7 +88,120 @@ func TestFlattenIngressRule(t *testing.T) {
 		sample := out[i]
 
 		if !reflect.DeepEqual(control, sample) {
-			t.Errorf("Unexpected result:\n\tWant:%s\n\tGot:%s\n", control, sample)
+			t.Errorf("Unexpected result:\n\tWant:%s\n\tGot:%s\n", sample, control)
 		}
 	}
 }
+
+func TestConvertIngressV1beta1RoundTrip(t *testing.T) {
+	spec := expandIngressSpec([]interface{}{
+		map[string]interface{}{
+			"ingress_class_name": "nginx",
+			"default_backend": []interface{}{
+				map[string]interface{}{
+					"service": []interface{}{
+						map[string]interface{}{
+							"name": "default",
+							"port": []interface{}{map[string]interface{}{"name": "http", "number": 0}},
+						},
+					},
+				},
+			},
+			"rule": []interface{}{
+				map[string]interface{}{
+					"host": "example.com",
+					"http": []interface{}{
+						map[string]interface{}{
+							"path": []interface{}{
+								map[string]interface{}{
+									"path":      "/",
+									"path_type": "Exact",
+									"backend": []interface{}{
+										map[string]interface{}{
+											"service": []interface{}{
+												map[string]interface{}{
+													"name": "app",
+													"port": []interface{}{map[string]interface{}{"name": "", "number": 8080}},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	in := &networking.Ingress{Spec: spec}
+
+	out := convertIngressToV1beta1(in)
+	if out.Spec.Backend.ServicePort.StrVal != "http" {
+		t.Fatalf("Expected named port, got %#v", out.Spec.Backend.ServicePort)
+	}
+	if out.Spec.Rules[0].HTTP.Paths[0].Backend.ServicePort.IntVal != 8080 {
+		t.Fatalf("Expected port number, got %#v", out.Spec.Rules[0].HTTP.Paths[0].Backend.ServicePort)
+	}
+	if back := convertIngressFromV1beta1(out); !reflect.DeepEqual(back.Spec, in.Spec) {
+		t.Fatalf("Unexpected round trip:\n%#v\nexpected:\n%#v", back.Spec, in.Spec)
+	}
+}
+
+func TestIngressStateUpgradeV1(t *testing.T) {
+	v1 := map[string]interface{}{
+		"spec": []interface{}{map[string]interface{}{
+			"backend": []interface{}{map[string]interface{}{
+				"service_name": "default",
+				"service_port": "http",
+			}},
+			"rule": []interface{}{map[string]interface{}{
+				"host": "example.com",
+				"http": []interface{}{map[string]interface{}{
+					"path": []interface{}{map[string]interface{}{
+						"path": "/",
+						"backend": []interface{}{map[string]interface{}{
+							"service_name": "app",
+							"service_port": "8080",
+						}},
+					}},
+				}},
+			}},
+		}},
+	}
+	expected := map[string]interface{}{
+		"spec": []interface{}{map[string]interface{}{
+			"default_backend": []interface{}{map[string]interface{}{
+				"service": []interface{}{map[string]interface{}{
+					"name": "default",
+					"port": []interface{}{map[string]interface{}{"name": "http", "number": 0}},
+				}},
+				"resource": []interface{}{},
+			}},
+			"rule": []interface{}{map[string]interface{}{
+				"host": "example.com",
+				"http": []interface{}{map[string]interface{}{
+					"path": []interface{}{map[string]interface{}{
+						"path":      "/",
+						"path_type": "ImplementationSpecific",
+						"backend": []interface{}{map[string]interface{}{
+							"service": []interface{}{map[string]interface{}{
+								"name": "app",
+								"port": []interface{}{map[string]interface{}{"name": "", "number": 8080}},
+							}},
+							"resource": []interface{}{},
+						}},
+					}},
+				}},
+			}},
+		}},
+	}
+
+	v2, err := resourceKubernetesIngressStateUpgradeV1(context.Background(), v1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(v2, expected) {
+		t.Fatalf("Unexpected state:\n%#v\nexpected:\n%#v", v2, expected)
+	}
+}
@@ -1,10 +1,14 @@
 package kubernetes
 
 import (
+	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func flattenJobSpec(in batchv1.JobSpec, d *schema.ResourceData, prefix ...string) ([]interface{}, error) {
@@ -18,10 +22,18 @@ func flattenJobSpec(in batchv1.JobSpec, d *schema.ResourceData, prefix ...string
 		att["backoff_limit"] = *in.BackoffLimit
 	}
 
+	if in.BackoffLimitPerIndex != nil {
+		att["backoff_limit_per_index"] = strconv.Itoa(int(*in.BackoffLimitPerIndex))
+	}
+
 	if in.Completions != nil {
 		att["completions"] = *in.Completions
 	}
 
+	if in.CompletionMode != nil {
+		att["completion_mode"] = string(*in.CompletionMode)
+	}
+
 	if in.ManualSelector != nil {
 		att["manual_selector"] = *in.ManualSelector
 	}
@@ -30,9 +42,18 @@ func flattenJobSpec(in batchv1.JobSpec, d *schema.ResourceData, prefix ...string
 		att["parallelism"] = *in.Parallelism
 	}
 
+	if in.PodFailurePolicy != nil {
+		att["pod_failure_policy"] = flattenJobPodFailurePolicy(in.PodFailurePolicy)
+	}
+
 	if in.Selector != nil {
 		att["selector"] = flattenLabelSelector(in.Selector)
 	}
+
+	if in.Suspend != nil {
+		att["suspend"] = *in.Suspend
+	}
+
 	// Remove server-generated labels
 	labels := in.Template.ObjectMeta.Labels
 
@@ -74,10 +95,23 @@ func expandJobSpec(j []interface{}) (batchv1.JobSpec, error) {
 		obj.BackoffLimit = ptrToInt32(int32(v))
 	}
 
+	if v, ok := in["backoff_limit_per_index"].(string); ok && v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return obj, err
+		}
+		obj.BackoffLimitPerIndex = ptrToInt32(int32(i))
+	}
+
 	if v, ok := in["completions"].(int); ok && v > 0 {
 		obj.Completions = ptrToInt32(int32(v))
 	}
 
+	if v, ok := in["completion_mode"].(string); ok && v != "" {
+		m := batchv1.CompletionMode(v)
+		obj.CompletionMode = &m
+	}
+
 	if v, ok := in["manual_selector"]; ok {
 		obj.ManualSelector = ptrToBool(v.(bool))
 	}
@@ -86,10 +120,18 @@ func expandJobSpec(j []interface{}) (batchv1.JobSpec, error) {
 		obj.Parallelism = ptrToInt32(int32(v))
 	}
 
+	if v, ok := in["pod_failure_policy"].([]interface{}); ok && len(v) > 0 {
+		obj.PodFailurePolicy = expandJobPodFailurePolicy(v)
+	}
+
 	if v, ok := in["selector"].([]interface{}); ok && len(v) > 0 {
 		obj.Selector = expandLabelSelector(v)
 	}
 
+	if v, ok := in["suspend"].(bool); ok && v {
+		obj.Suspend = ptrToBool(v)
+	}
+
 	template, err := expandPodTemplate(in["template"].([]interface{}))
 	if err != nil {
 		return obj, err
@@ -107,6 +149,88 @@ func expandJobSpec(j []interface{}) (batchv1.JobSpec, error) {
 	return obj, nil
 }
 
+func flattenJobPodFailurePolicy(in *batchv1.PodFailurePolicy) []interface{} {
+	rules := make([]interface{}, len(in.Rules))
+	for i, r := range in.Rules {
+		rule := map[string]interface{}{
+			"action": string(r.Action),
+		}
+		if r.OnExitCodes != nil {
+			values := make([]interface{}, len(r.OnExitCodes.Values))
+			for j, v := range r.OnExitCodes.Values {
+				values[j] = int(v)
+			}
+			onExitCodes := map[string]interface{}{
+				"operator": string(r.OnExitCodes.Operator),
+				"values":   values,
+			}
+			if r.OnExitCodes.ContainerName != nil {
+				onExitCodes["container_name"] = *r.OnExitCodes.ContainerName
+			}
+			rule["on_exit_codes"] = []interface{}{onExitCodes}
+		}
+		if len(r.OnPodConditions) > 0 {
+			conditions := make([]interface{}, len(r.OnPodConditions))
+			for j, c := range r.OnPodConditions {
+				conditions[j] = map[string]interface{}{
+					"type":   string(c.Type),
+					"status": string(c.Status),
+				}
+			}
+			rule["on_pod_conditions"] = conditions
+		}
+		rules[i] = rule
+	}
+	return []interface{}{map[string]interface{}{
+		"rule": rules,
+	}}
+}
+
+func expandJobPodFailurePolicy(l []interface{}) *batchv1.PodFailurePolicy {
+	obj := &batchv1.PodFailurePolicy{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	rules, ok := in["rule"].([]interface{})
+	if !ok {
+		return obj
+	}
+	obj.Rules = make([]batchv1.PodFailurePolicyRule, len(rules))
+	for i, v := range rules {
+		r := v.(map[string]interface{})
+		rule := batchv1.PodFailurePolicyRule{
+			Action: batchv1.PodFailurePolicyAction(r["action"].(string)),
+		}
+		if v, ok := r["on_exit_codes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			e := v[0].(map[string]interface{})
+			onExitCodes := &batchv1.PodFailurePolicyOnExitCodesRequirement{
+				Operator: batchv1.PodFailurePolicyOnExitCodesOperator(e["operator"].(string)),
+			}
+			if v, ok := e["container_name"].(string); ok && v != "" {
+				onExitCodes.ContainerName = ptrToString(v)
+			}
+			if v, ok := e["values"].([]interface{}); ok {
+				for _, code := range v {
+					onExitCodes.Values = append(onExitCodes.Values, int32(code.(int)))
+				}
+			}
+			rule.OnExitCodes = onExitCodes
+		}
+		if v, ok := r["on_pod_conditions"].([]interface{}); ok {
+			for _, c := range v {
+				cond := c.(map[string]interface{})
+				rule.OnPodConditions = append(rule.OnPodConditions, batchv1.PodFailurePolicyOnPodConditionsPattern{
+					Type:   corev1.PodConditionType(cond["type"].(string)),
+					Status: corev1.ConditionStatus(cond["status"].(string)),
+				})
+			}
+		}
+		obj.Rules[i] = rule
+	}
+	return obj
+}
+
 func patchJobSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOperations, error) {
 	ops := make([]PatchOperation, 0)
 
@@ -142,5 +266,86 @@ func patchJobSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOpera
 		})
 	}
 
+	if d.HasChange(prefix + "suspend") {
+		v := d.Get(prefix + "suspend").(bool)
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "/suspend",
+			Value: v,
+		})
+	}
+
 	return ops, nil
 }
+
+// podFailurePolicyRuleIndex extracts the index of the pod failure policy rule
+// from the message of the condition failing a job, e.g. `Container main for
+// pod default/job-abc failed with exit code 42 matching FailJob rule at index 0`.
+var podFailurePolicyRuleIndex = regexp.MustCompile(`matching \w+ rule at index (\d+)`)
+
+// describeJobFailure returns the reason and message of the condition failing
+// the job, or an empty string when the job isn't failing. The message says
+// which limit was reached, e.g. `BackoffLimitExceeded`, or which pod failure
+// policy rule matched, which is then described from the job spec.
+func describeJobFailure(job *batchv1.Job) string {
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		// The FailureTarget condition is added by newer clusters while the
+		// pods of a job failed by a pod failure policy rule are terminated.
+		if c.Type == batchv1.JobFailed || c.Type == batchv1.JobFailureTarget {
+			if c.Message == "" {
+				return c.Reason
+			}
+			failure := fmt.Sprintf("%s: %s", c.Reason, c.Message)
+			if rule := matchedPodFailurePolicyRule(job.Spec, c.Message); rule != "" {
+				failure += fmt.Sprintf(" (%s)", rule)
+			}
+			return failure
+		}
+	}
+	return ""
+}
+
+// matchedPodFailurePolicyRule describes the pod failure policy rule named by
+// the message of a job condition, or returns an empty string when there is none.
+func matchedPodFailurePolicyRule(spec batchv1.JobSpec, message string) string {
+	m := podFailurePolicyRuleIndex.FindStringSubmatch(message)
+	if m == nil || spec.PodFailurePolicy == nil {
+		return ""
+	}
+	i, err := strconv.Atoi(m[1])
+	if err != nil || i >= len(spec.PodFailurePolicy.Rules) {
+		return ""
+	}
+	return fmt.Sprintf("pod_failure_policy rule %d: %s", i, describePodFailurePolicyRule(spec.PodFailurePolicy.Rules[i]))
+}
+
+func describePodFailurePolicyRule(rule batchv1.PodFailurePolicyRule) string {
+	if e := rule.OnExitCodes; e != nil {
+		container := "any container"
+		if e.ContainerName != nil {
+			container = fmt.Sprintf("container %s", *e.ContainerName)
+		}
+		return fmt.Sprintf("%s when the exit code of %s is %s %v", rule.Action, container, e.Operator, e.Values)
+	}
+	conditions := make([]string, len(rule.OnPodConditions))
+	for i, c := range rule.OnPodConditions {
+		conditions[i] = fmt.Sprintf("%s=%s", c.Type, c.Status)
+	}
+	return fmt.Sprintf("%s when the pod has condition %s", rule.Action, strings.Join(conditions, " or "))
+}
+
+// describeJobProgress summarizes the pods of an unfinished job.
+func describeJobProgress(status batchv1.JobStatus) string {
+	progress := fmt.Sprintf("%d active, %d succeeded and %d failed pods", status.Active, status.Succeeded, status.Failed)
+	if status.CompletedIndexes != "" {
+		progress += fmt.Sprintf(", completed indexes %s", status.CompletedIndexes)
+	}
+	for _, c := range status.Conditions {
+		if c.Type == batchv1.JobSuspended && c.Status == corev1.ConditionTrue {
+			progress += ", suspended"
+		}
+	}
+	return progress
+}
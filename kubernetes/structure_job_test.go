@@ -0,0 +1,192 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestExpandJobSpec_indexedAndSuspended(t *testing.T) {
+	spec, err := expandJobSpec([]interface{}{map[string]interface{}{
+		"completion_mode": "Indexed",
+		"completions":     4,
+		"parallelism":     2,
+		"suspend":         true,
+		"template":        []interface{}{},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.CompletionMode == nil || *spec.CompletionMode != batchv1.IndexedCompletion {
+		t.Fatalf("Expected completion mode %q, got %v", batchv1.IndexedCompletion, spec.CompletionMode)
+	}
+	if spec.Suspend == nil || !*spec.Suspend {
+		t.Fatalf("Expected the job to be suspended, got %v", spec.Suspend)
+	}
+
+	spec, err = expandJobSpec([]interface{}{map[string]interface{}{
+		"completion_mode": "",
+		"suspend":         false,
+		"template":        []interface{}{},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Unset fields are left to the API server defaults
+	if spec.CompletionMode != nil || spec.Suspend != nil {
+		t.Fatalf("Expected completion mode and suspend to be unset, got %v and %v", spec.CompletionMode, spec.Suspend)
+	}
+}
+
+func TestExpandThenFlattenJobSpec_podFailurePolicy(t *testing.T) {
+	in := map[string]interface{}{
+		"backoff_limit_per_index": "0",
+		"completion_mode":         "Indexed",
+		"pod_failure_policy": []interface{}{map[string]interface{}{
+			"rule": []interface{}{
+				map[string]interface{}{
+					"action": "FailJob",
+					"on_exit_codes": []interface{}{map[string]interface{}{
+						"container_name": "main",
+						"operator":       "In",
+						"values":         []interface{}{1, 42},
+					}},
+				},
+				map[string]interface{}{
+					"action": "Ignore",
+					"on_pod_conditions": []interface{}{map[string]interface{}{
+						"type":   "DisruptionTarget",
+						"status": "True",
+					}},
+				},
+			},
+		}},
+		"template": []interface{}{},
+	}
+
+	spec, err := expandJobSpec([]interface{}{in})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &batchv1.PodFailurePolicy{
+		Rules: []batchv1.PodFailurePolicyRule{
+			{
+				Action: batchv1.PodFailurePolicyActionFailJob,
+				OnExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+					ContainerName: ptrToString("main"),
+					Operator:      batchv1.PodFailurePolicyOnExitCodesOpIn,
+					Values:        []int32{1, 42},
+				},
+			},
+			{
+				Action: batchv1.PodFailurePolicyActionIgnore,
+				OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{
+					{Type: v1.DisruptionTarget, Status: v1.ConditionTrue},
+				},
+			},
+		},
+	}
+	if !cmp.Equal(expected, spec.PodFailurePolicy) {
+		t.Fatal(cmp.Diff(expected, spec.PodFailurePolicy))
+	}
+	// Zero retries per index is set, not left to the default
+	if spec.BackoffLimitPerIndex == nil || *spec.BackoffLimitPerIndex != 0 {
+		t.Fatalf("Expected a backoff limit per index of 0, got %v", spec.BackoffLimitPerIndex)
+	}
+
+	flattened := flattenJobPodFailurePolicy(spec.PodFailurePolicy)
+	if !cmp.Equal(in["pod_failure_policy"], flattened) {
+		t.Fatal(cmp.Diff(in["pod_failure_policy"], flattened))
+	}
+}
+
+func TestDescribeJobFailure(t *testing.T) {
+	spec := batchv1.JobSpec{
+		PodFailurePolicy: &batchv1.PodFailurePolicy{
+			Rules: []batchv1.PodFailurePolicyRule{
+				{
+					Action: batchv1.PodFailurePolicyActionIgnore,
+					OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{
+						{Type: v1.DisruptionTarget, Status: v1.ConditionTrue},
+					},
+				},
+				{
+					Action: batchv1.PodFailurePolicyActionFailJob,
+					OnExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+						ContainerName: ptrToString("main"),
+						Operator:      batchv1.PodFailurePolicyOnExitCodesOpIn,
+						Values:        []int32{42},
+					},
+				},
+				{
+					Action: batchv1.PodFailurePolicyActionFailJob,
+					OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{
+						{Type: "ConfigIssue", Status: v1.ConditionTrue},
+					},
+				},
+			},
+		},
+	}
+	cases := map[string]struct {
+		status   batchv1.JobStatus
+		expected string
+	}{
+		"running": {
+			batchv1.JobStatus{Active: 1},
+			"",
+		},
+		"backoff limit": {
+			batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Reason: "BackoffLimitExceeded", Message: "Job has reached the specified backoff limit"},
+			}},
+			"BackoffLimitExceeded: Job has reached the specified backoff limit",
+		},
+		"pod failure policy exit code": {
+			batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailureTarget, Status: v1.ConditionTrue, Reason: "PodFailurePolicy", Message: "Container main for pod default/job-abc failed with exit code 42 matching FailJob rule at index 1"},
+			}},
+			"PodFailurePolicy: Container main for pod default/job-abc failed with exit code 42 matching FailJob rule at index 1 (pod_failure_policy rule 1: FailJob when the exit code of container main is In [42])",
+		},
+		"pod failure policy condition": {
+			batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Reason: "PodFailurePolicy", Message: "Pod default/job-abc has condition ConfigIssue matching FailJob rule at index 2"},
+			}},
+			"PodFailurePolicy: Pod default/job-abc has condition ConfigIssue matching FailJob rule at index 2 (pod_failure_policy rule 2: FailJob when the pod has condition ConfigIssue=True)",
+		},
+		"pod failure policy out of range": {
+			batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Reason: "PodFailurePolicy", Message: "Pod default/job-abc has condition ConfigIssue matching FailJob rule at index 5"},
+			}},
+			"PodFailurePolicy: Pod default/job-abc has condition ConfigIssue matching FailJob rule at index 5",
+		},
+		"resumed": {
+			batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobSuspended, Status: v1.ConditionFalse, Reason: "JobResumed"},
+			}},
+			"",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			job := &batchv1.Job{Spec: spec, Status: tc.status}
+			if out := describeJobFailure(job); out != tc.expected {
+				t.Fatalf("Expected %q, got %q", tc.expected, out)
+			}
+		})
+	}
+}
+
+func TestDescribeJobProgress(t *testing.T) {
+	status := batchv1.JobStatus{
+		Active:           2,
+		Succeeded:        3,
+		Failed:           1,
+		CompletedIndexes: "0-2",
+	}
+	expected := "2 active, 3 succeeded and 1 failed pods, completed indexes 0-2"
+	if out := describeJobProgress(status); out != expected {
+		t.Fatalf("Expected %q, got %q", expected, out)
+	}
+}
@@ -2,6 +2,8 @@ package kubernetes
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"k8s.io/api/core/v1"
@@ -23,6 +25,66 @@ func flattenPersistentVolumeClaimSpec(in v1.PersistentVolumeClaimSpec) []interfa
 	if in.StorageClassName != nil {
 		att["storage_class_name"] = *in.StorageClassName
 	}
+	if in.VolumeMode != nil {
+		att["volume_mode"] = string(*in.VolumeMode)
+	}
+	if in.DataSource != nil {
+		att["data_source"] = flattenTypedLocalObjectReference(in.DataSource)
+	}
+	if in.DataSourceRef != nil {
+		att["data_source_ref"] = flattenTypedObjectReference(in.DataSourceRef)
+	}
+	return []interface{}{att}
+}
+
+func flattenTypedLocalObjectReference(in *v1.TypedLocalObjectReference) []interface{} {
+	att := map[string]interface{}{
+		"kind": in.Kind,
+		"name": in.Name,
+	}
+	if in.APIGroup != nil {
+		att["api_group"] = *in.APIGroup
+	}
+	return []interface{}{att}
+}
+
+func flattenTypedObjectReference(in *v1.TypedObjectReference) []interface{} {
+	att := map[string]interface{}{
+		"kind": in.Kind,
+		"name": in.Name,
+	}
+	if in.APIGroup != nil {
+		att["api_group"] = *in.APIGroup
+	}
+	if in.Namespace != nil {
+		att["namespace"] = *in.Namespace
+	}
+	return []interface{}{att}
+}
+
+func flattenPersistentVolumeClaimStatus(in v1.PersistentVolumeClaimStatus) []interface{} {
+	att := make(map[string]interface{})
+	att["phase"] = string(in.Phase)
+	if len(in.Capacity) > 0 {
+		att["capacity"] = flattenResourceList(in.Capacity)
+	}
+	conditions := make([]interface{}, len(in.Conditions))
+	for i, c := range in.Conditions {
+		condition := map[string]interface{}{
+			"type":    string(c.Type),
+			"status":  string(c.Status),
+			"reason":  c.Reason,
+			"message": c.Message,
+		}
+		if !c.LastProbeTime.IsZero() {
+			condition["last_probe_time"] = c.LastProbeTime.Format(time.RFC3339)
+		}
+		if !c.LastTransitionTime.IsZero() {
+			condition["last_transition_time"] = c.LastTransitionTime.Format(time.RFC3339)
+		}
+		conditions[i] = condition
+	}
+	att["conditions"] = conditions
 	return []interface{}{att}
 }
 
@@ -82,9 +144,50 @@ func expandPersistentVolumeClaimSpec(l []interface{}) (*v1.PersistentVolumeClaim
 	if v, ok := in["storage_class_name"].(string); ok && v != "" {
 		obj.StorageClassName = ptrToString(v)
 	}
+	if v, ok := in["volume_mode"].(string); ok && v != "" {
+		mode := v1.PersistentVolumeMode(v)
+		obj.VolumeMode = &mode
+	}
+	if v, ok := in["data_source"].([]interface{}); ok && len(v) > 0 {
+		obj.DataSource = expandTypedLocalObjectReference(v)
+	}
+	if v, ok := in["data_source_ref"].([]interface{}); ok && len(v) > 0 {
+		obj.DataSourceRef = expandTypedObjectReference(v)
+	}
 	return obj, nil
 }
 
+func expandTypedLocalObjectReference(l []interface{}) *v1.TypedLocalObjectReference {
+	obj := &v1.TypedLocalObjectReference{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	obj.Kind = in["kind"].(string)
+	obj.Name = in["name"].(string)
+	if v, ok := in["api_group"].(string); ok && v != "" {
+		obj.APIGroup = ptrToString(v)
+	}
+	return obj
+}
+
+func expandTypedObjectReference(l []interface{}) *v1.TypedObjectReference {
+	obj := &v1.TypedObjectReference{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	obj.Kind = in["kind"].(string)
+	obj.Name = in["name"].(string)
+	if v, ok := in["api_group"].(string); ok && v != "" {
+		obj.APIGroup = ptrToString(v)
+	}
+	if v, ok := in["namespace"].(string); ok && v != "" {
+		obj.Namespace = ptrToString(v)
+	}
+	return obj
+}
+
 func expandResourceRequirements(l []interface{}) (*v1.ResourceRequirements, error) {
 	obj := &v1.ResourceRequirements{}
 	if len(l) == 0 || l[0] == nil {
@@ -107,3 +210,23 @@ func expandResourceRequirements(l []interface{}) (*v1.ResourceRequirements, erro
 	}
 	return obj, nil
 }
+
+// persistentVolumeClaimResizeProgress returns the condition and message of a
+// resize in progress, or an empty condition once the claim is resized.
+func persistentVolumeClaimResizeProgress(claim *v1.PersistentVolumeClaim) (v1.PersistentVolumeClaimConditionType, string) {
+	for _, c := range claim.Status.Conditions {
+		if c.Status != v1.ConditionTrue {
+			continue
+		}
+		if c.Type == v1.PersistentVolumeClaimResizing || c.Type == v1.PersistentVolumeClaimFileSystemResizePending {
+			return c.Type, c.Message
+		}
+	}
+	requested := claim.Spec.Resources.Requests[v1.ResourceStorage]
+	capacity, ok := claim.Status.Capacity[v1.ResourceStorage]
+	if ok && capacity.Cmp(requested) == -1 {
+		// The resizer didn't pick up the request yet
+		return v1.PersistentVolumeClaimResizing, fmt.Sprintf("capacity %s is less than the requested %s", capacity.String(), requested.String())
+	}
+	return "", ""
+}
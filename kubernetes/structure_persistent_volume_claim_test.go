@@ -0,0 +1,142 @@
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExpandThenFlatten_persistentVolumeClaimSpec(t *testing.T) {
+	block := v1.PersistentVolumeBlock
+	in := &v1.PersistentVolumeClaimSpec{
+		AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+		Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{
+				v1.ResourceStorage: resource.MustParse("10Gi"),
+			},
+		},
+		StorageClassName: ptrToString("csi-rbd"),
+		VolumeMode:       &block,
+		DataSource: &v1.TypedLocalObjectReference{
+			APIGroup: ptrToString("snapshot.storage.k8s.io"),
+			Kind:     "VolumeSnapshot",
+			Name:     "database-snapshot",
+		},
+		DataSourceRef: &v1.TypedObjectReference{
+			APIGroup:  ptrToString("snapshot.storage.k8s.io"),
+			Kind:      "VolumeSnapshot",
+			Name:      "database-snapshot",
+			Namespace: ptrToString("backups"),
+		},
+	}
+
+	// Set the spec in the state, so that the maps are of the types read by the expander
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"spec": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Resource{Schema: persistentVolumeClaimSpecFields()},
+		},
+	}, map[string]interface{}{})
+	if err := d.Set("spec", flattenPersistentVolumeClaimSpec(*in)); err != nil {
+		t.Fatal(err)
+	}
+	out, err := expandPersistentVolumeClaimSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(in, out) {
+		t.Fatal(cmp.Diff(in, out))
+	}
+}
+
+func TestFlattenPersistentVolumeClaimStatus(t *testing.T) {
+	transition := metav1.NewTime(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC))
+	in := v1.PersistentVolumeClaimStatus{
+		Phase: v1.ClaimBound,
+		Capacity: v1.ResourceList{
+			v1.ResourceStorage: resource.MustParse("10Gi"),
+		},
+		Conditions: []v1.PersistentVolumeClaimCondition{
+			{
+				Type:               v1.PersistentVolumeClaimFileSystemResizePending,
+				Status:             v1.ConditionTrue,
+				Message:            "Waiting for user to (re-)start a pod to finish file system resize of volume on node.",
+				LastTransitionTime: transition,
+			},
+		},
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"phase":    "Bound",
+			"capacity": map[string]string{"storage": "10Gi"},
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"type":                 "FileSystemResizePending",
+					"status":               "True",
+					"reason":               "",
+					"message":              "Waiting for user to (re-)start a pod to finish file system resize of volume on node.",
+					"last_transition_time": "2021-06-01T12:00:00Z",
+				},
+			},
+		},
+	}
+	out := flattenPersistentVolumeClaimStatus(in)
+	if !cmp.Equal(expected, out) {
+		t.Fatal(cmp.Diff(expected, out))
+	}
+}
+
+func TestPersistentVolumeClaimResizeProgress(t *testing.T) {
+	claim := func(requested, capacity string, conditions ...v1.PersistentVolumeClaimCondition) *v1.PersistentVolumeClaim {
+		return &v1.PersistentVolumeClaim{
+			Spec: v1.PersistentVolumeClaimSpec{
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse(requested)},
+				},
+			},
+			Status: v1.PersistentVolumeClaimStatus{
+				Capacity:   v1.ResourceList{v1.ResourceStorage: resource.MustParse(capacity)},
+				Conditions: conditions,
+			},
+		}
+	}
+	cases := map[string]struct {
+		claim    *v1.PersistentVolumeClaim
+		expected v1.PersistentVolumeClaimConditionType
+	}{
+		"resized": {
+			claim("2Gi", "2Gi"),
+			"",
+		},
+		"not picked up": {
+			claim("2Gi", "1Gi"),
+			v1.PersistentVolumeClaimResizing,
+		},
+		"resizing": {
+			claim("2Gi", "1Gi", v1.PersistentVolumeClaimCondition{Type: v1.PersistentVolumeClaimResizing, Status: v1.ConditionTrue}),
+			v1.PersistentVolumeClaimResizing,
+		},
+		"file system resize pending": {
+			claim("2Gi", "2Gi", v1.PersistentVolumeClaimCondition{Type: v1.PersistentVolumeClaimFileSystemResizePending, Status: v1.ConditionTrue}),
+			v1.PersistentVolumeClaimFileSystemResizePending,
+		},
+		"cleared condition": {
+			claim("2Gi", "2Gi", v1.PersistentVolumeClaimCondition{Type: v1.PersistentVolumeClaimResizing, Status: v1.ConditionFalse}),
+			"",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			condition, _ := persistentVolumeClaimResizeProgress(tc.claim)
+			if condition != tc.expected {
+				t.Fatalf("Expected condition %q, got %q", tc.expected, condition)
+			}
+		})
+	}
+}
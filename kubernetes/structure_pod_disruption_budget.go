@@ -4,7 +4,8 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	api "k8s.io/api/policy/v1beta1"
+	api "k8s.io/api/policy/v1"
+	"k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -25,6 +26,10 @@ func expandPodDisruptionBudgetSpec(in []interface{}) (*api.PodDisruptionBudgetSp
 	if v, ok := m["selector"].([]interface{}); ok && len(v) > 0 {
 		spec.Selector = expandLabelSelector(v)
 	}
+	if v, ok := m["unhealthy_pod_eviction_policy"].(string); ok && len(v) > 0 {
+		policy := api.UnhealthyPodEvictionPolicyType(v)
+		spec.UnhealthyPodEvictionPolicy = &policy
+	}
 
 	return spec, nil
 }
@@ -40,10 +45,55 @@ func flattenPodDisruptionBudgetSpec(spec api.PodDisruptionBudgetSpec) []interfac
 	if spec.Selector != nil {
 		m["selector"] = flattenLabelSelector(spec.Selector)
 	}
+	if spec.UnhealthyPodEvictionPolicy != nil {
+		m["unhealthy_pod_eviction_policy"] = string(*spec.UnhealthyPodEvictionPolicy)
+	}
+
+	return []interface{}{m}
+}
+
+func flattenPodDisruptionBudgetStatus(status api.PodDisruptionBudgetStatus) []interface{} {
+	m := map[string]interface{}{
+		"current_healthy":     int(status.CurrentHealthy),
+		"desired_healthy":     int(status.DesiredHealthy),
+		"disruptions_allowed": int(status.DisruptionsAllowed),
+		"expected_pods":       int(status.ExpectedPods),
+	}
 
 	return []interface{}{m}
 }
 
+func convertPodDisruptionBudgetToV1beta1(in *api.PodDisruptionBudget) *v1beta1.PodDisruptionBudget {
+	return &v1beta1.PodDisruptionBudget{
+		ObjectMeta: in.ObjectMeta,
+		Spec: v1beta1.PodDisruptionBudgetSpec{
+			MinAvailable:   in.Spec.MinAvailable,
+			Selector:       in.Spec.Selector,
+			MaxUnavailable: in.Spec.MaxUnavailable,
+		},
+	}
+}
+
+func convertPodDisruptionBudgetFromV1beta1(in *v1beta1.PodDisruptionBudget) *api.PodDisruptionBudget {
+	return &api.PodDisruptionBudget{
+		ObjectMeta: in.ObjectMeta,
+		Spec: api.PodDisruptionBudgetSpec{
+			MinAvailable:   in.Spec.MinAvailable,
+			Selector:       in.Spec.Selector,
+			MaxUnavailable: in.Spec.MaxUnavailable,
+		},
+		Status: api.PodDisruptionBudgetStatus{
+			ObservedGeneration: in.Status.ObservedGeneration,
+			DisruptedPods:      in.Status.DisruptedPods,
+			DisruptionsAllowed: in.Status.DisruptionsAllowed,
+			CurrentHealthy:     in.Status.CurrentHealthy,
+			DesiredHealthy:     in.Status.DesiredHealthy,
+			ExpectedPods:       in.Status.ExpectedPods,
+			Conditions:         in.Status.Conditions,
+		},
+	}
+}
+
 // Currently unused, but will be useful for Kubernetes 1.15 when patching is allowed.
 func patchPodDisruptionBudgetSpec(prefix string, pathPrefix string, d *schema.ResourceData) (*[]PatchOperation, error) {
 	ops := make([]PatchOperation, 0)
@@ -0,0 +1,45 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	api "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestExpandThenFlattenPodDisruptionBudgetSpec(t *testing.T) {
+	maxUnavailable := intstr.FromInt(1)
+	alwaysAllow := api.AlwaysAllow
+	testCases := []struct {
+		Name   string
+		Policy *api.UnhealthyPodEvictionPolicyType
+	}{
+		{"without policy", nil},
+		{"with policy", &alwaysAllow},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			in := &api.PodDisruptionBudgetSpec{
+				MaxUnavailable: &maxUnavailable,
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "web"},
+				},
+				UnhealthyPodEvictionPolicy: tc.Policy,
+			}
+
+			flattened := flattenPodDisruptionBudgetSpec(*in)
+			// The expander reads the selector labels as set in the state
+			selector := flattened[0].(map[string]interface{})["selector"].([]interface{})[0].(map[string]interface{})
+			selector["match_labels"] = map[string]interface{}{"app": "web"}
+			out, err := expandPodDisruptionBudgetSpec(flattened)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(in, out) {
+				t.Fatalf("Round trip mismatch.\nExpected: %#v\nGot:      %#v", in, out)
+			}
+		})
+	}
+}
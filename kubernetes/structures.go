@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net/url"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -134,6 +135,22 @@ func flattenMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, metaPrefix
 	return []interface{}{m}
 }
 
+// flattenResourceMetadata flattens the metadata of the object managed by a resource
+// or read by a data source, without the annotations and labels ignored by the provider.
+func flattenResourceMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, providerMetadata interface{}) []interface{} {
+	out := flattenMetadata(meta, d)
+	k, ok := providerMetadata.(KubeClientsets)
+	if !ok {
+		return out
+	}
+	m := out[0].(map[string]interface{})
+	configAnnotations := d.Get("metadata.0.annotations").(map[string]interface{})
+	m["annotations"] = removeKeys(m["annotations"].(map[string]string), configAnnotations, k.IgnoreAnnotations())
+	configLabels := d.Get("metadata.0.labels").(map[string]interface{})
+	m["labels"] = removeKeys(m["labels"].(map[string]string), configLabels, k.IgnoreLabels())
+	return out
+}
+
 func removeInternalKeys(m map[string]string, d map[string]interface{}) map[string]string {
 	for k := range m {
 		if isInternalKey(k) && !isKeyInMap(k, d) {
@@ -143,6 +160,38 @@ func removeInternalKeys(m map[string]string, d map[string]interface{}) map[strin
 	return m
 }
 
+// removeKeys drops the keys matching any of the ignored expressions,
+// unless they are set in the configuration.
+func removeKeys(m map[string]string, d map[string]interface{}, ignoreKeys []*regexp.Regexp) map[string]string {
+	for k := range m {
+		if isKeyInMap(k, d) {
+			continue
+		}
+		for _, r := range ignoreKeys {
+			if r.MatchString(k) {
+				delete(m, k)
+				break
+			}
+		}
+	}
+	return m
+}
+
+func expandRegexpList(in []interface{}) ([]*regexp.Regexp, error) {
+	out := make([]*regexp.Regexp, 0, len(in))
+	for _, v := range in {
+		if v == nil {
+			continue
+		}
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
 func isKeyInMap(key string, d map[string]interface{}) bool {
 	if d == nil {
 		return false
@@ -1,7 +1,13 @@
 package kubernetes
 
 import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	certificatesv1 "k8s.io/api/certificates/v1"
 	"k8s.io/api/certificates/v1beta1"
 )
 
@@ -12,6 +18,9 @@ func expandCertificateSigningRequestSpec(csr []interface{}) (*v1beta1.Certificat
 	}
 	in := csr[0].(map[string]interface{})
 	obj.Request = []byte(in["request"].(string))
+	if v, ok := in["signer_name"].(string); ok && v != "" {
+		obj.SignerName = ptrToString(v)
+	}
 	if v, ok := in["usages"].(*schema.Set); ok && v.Len() > 0 {
 		obj.Usages = expandCertificateSigningRequestUsages(v.List())
 	}
@@ -25,3 +34,43 @@ func expandCertificateSigningRequestUsages(s []interface{}) []v1beta1.KeyUsage {
 	}
 	return out
 }
+
+func expandCertificateSigningRequestV1Spec(csr []interface{}) (*certificatesv1.CertificateSigningRequestSpec, error) {
+	obj := &certificatesv1.CertificateSigningRequestSpec{}
+	if len(csr) == 0 || csr[0] == nil {
+		return obj, nil
+	}
+	in := csr[0].(map[string]interface{})
+	obj.Request = []byte(in["request"].(string))
+	if v, ok := in["signer_name"].(string); ok {
+		obj.SignerName = v
+	}
+	if v, ok := in["usages"].(*schema.Set); ok && v.Len() > 0 {
+		obj.Usages = expandCertificateSigningRequestV1Usages(v.List())
+	}
+	if v, ok := in["expiration_seconds"].(int); ok && v > 0 {
+		obj.ExpirationSeconds = ptrToInt32(int32(v))
+	}
+	return obj, nil
+}
+
+func expandCertificateSigningRequestV1Usages(s []interface{}) []certificatesv1.KeyUsage {
+	out := make([]certificatesv1.KeyUsage, len(s), len(s))
+	for i, v := range s {
+		out[i] = certificatesv1.KeyUsage(v.(string))
+	}
+	return out
+}
+
+// certificateExpiration returns the end of the validity period of a PEM encoded certificate.
+func certificateExpiration(certificate string) (time.Time, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil {
+		return time.Time{}, fmt.Errorf("failed to decode PEM encoded certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse certificate: %s", err)
+	}
+	return cert.NotAfter, nil
+}
@@ -0,0 +1,82 @@
+package kubernetes
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	certificatesv1 "k8s.io/api/certificates/v1"
+)
+
+func TestExpandCertificateSigningRequestV1Spec(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"request":            "request",
+			"signer_name":        "kubernetes.io/kube-apiserver-client",
+			"expiration_seconds": 3600,
+			"usages":             schema.NewSet(schema.HashString, []interface{}{"client auth"}),
+		},
+	}
+
+	spec, err := expandCertificateSigningRequestV1Spec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.SignerName != "kubernetes.io/kube-apiserver-client" {
+		t.Fatalf("Unexpected signer name: %s", spec.SignerName)
+	}
+	if spec.ExpirationSeconds == nil || *spec.ExpirationSeconds != 3600 {
+		t.Fatalf("Expected expirationSeconds to be 3600, got %v", spec.ExpirationSeconds)
+	}
+	if len(spec.Usages) != 1 || spec.Usages[0] != certificatesv1.UsageClientAuth {
+		t.Fatalf("Unexpected usages: %v", spec.Usages)
+	}
+
+	in[0].(map[string]interface{})["expiration_seconds"] = 0
+	spec, err = expandCertificateSigningRequestV1Spec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.ExpirationSeconds != nil {
+		t.Fatalf("Expected expirationSeconds to be unset, got %d", *spec.ExpirationSeconds)
+	}
+}
+
+func TestCertificateExpiration(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	notAfter := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "admin"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	expiration, err := certificateExpiration(certificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !expiration.Equal(notAfter) {
+		t.Fatalf("Expected expiration %s, got %s", notAfter, expiration)
+	}
+
+	_, err = certificateExpiration("not a certificate")
+	if err == nil {
+		t.Fatal("Expected an error for an invalid certificate")
+	}
+}
@@ -44,6 +44,12 @@ func flattenContainerSecurityContext(in *v1.SecurityContext) []interface{} {
 	if in.SELinuxOptions != nil {
 		att["se_linux_options"] = flattenSeLinuxOptions(in.SELinuxOptions)
 	}
+	if in.SeccompProfile != nil {
+		att["seccomp_profile"] = flattenSeccompProfile(in.SeccompProfile)
+	}
+	if in.WindowsOptions != nil {
+		att["windows_options"] = flattenWindowsOptions(in.WindowsOptions)
+	}
 	return []interface{}{att}
 
 }
@@ -61,7 +67,7 @@ func flattenSecurityCapabilities(in *v1.Capabilities) []interface{} {
 	return []interface{}{att}
 }
 
-func flattenHandler(in *v1.Handler) []interface{} {
+func flattenHandler(in *v1.LifecycleHandler) []interface{} {
 	att := make(map[string]interface{})
 
 	if in.Exec != nil {
@@ -619,6 +625,12 @@ func expandContainerSecurityContext(l []interface{}) (*v1.SecurityContext, error
 	if v, ok := in["se_linux_options"].([]interface{}); ok && len(v) > 0 {
 		obj.SELinuxOptions = expandSeLinuxOptions(v)
 	}
+	if v, ok := in["seccomp_profile"].([]interface{}); ok && len(v) > 0 {
+		obj.SeccompProfile = expandSeccompProfile(v)
+	}
+	if v, ok := in["windows_options"].([]interface{}); ok && len(v) > 0 {
+		obj.WindowsOptions = expandWindowsOptions(v)
+	}
 
 	return &obj, nil
 }
@@ -718,12 +730,12 @@ func expandProbe(l []interface{}) *v1.Probe {
 	return &obj
 }
 
-func expandHandlers(l []interface{}) *v1.Handler {
+func expandHandlers(l []interface{}) *v1.LifecycleHandler {
 	if len(l) == 0 || l[0] == nil {
-		return &v1.Handler{}
+		return &v1.LifecycleHandler{}
 	}
 	in := l[0].(map[string]interface{})
-	obj := v1.Handler{}
+	obj := v1.LifecycleHandler{}
 	if v, ok := in["exec"].([]interface{}); ok && len(v) > 0 {
 		obj.Exec = expandExec(v)
 	}
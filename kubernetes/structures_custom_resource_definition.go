@@ -0,0 +1,357 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// Flatteners
+
+func flattenCustomResourceDefinitionSpec(in apiextensionsv1.CustomResourceDefinitionSpec) ([]interface{}, error) {
+	att := make(map[string]interface{})
+
+	att["group"] = in.Group
+	att["scope"] = string(in.Scope)
+	att["names"] = flattenCustomResourceDefinitionNames(in.Names)
+	att["preserve_unknown_fields"] = in.PreserveUnknownFields
+
+	versions := make([]interface{}, len(in.Versions))
+	for i, v := range in.Versions {
+		version, err := flattenCustomResourceDefinitionVersion(v)
+		if err != nil {
+			return nil, err
+		}
+		versions[i] = version
+	}
+	att["version"] = versions
+
+	if in.Conversion != nil {
+		att["conversion"] = flattenCustomResourceConversion(*in.Conversion)
+	}
+
+	return []interface{}{att}, nil
+}
+
+func flattenCustomResourceDefinitionNames(in apiextensionsv1.CustomResourceDefinitionNames) []interface{} {
+	att := make(map[string]interface{})
+
+	att["plural"] = in.Plural
+	att["singular"] = in.Singular
+	att["kind"] = in.Kind
+	att["list_kind"] = in.ListKind
+	att["short_names"] = flattenListOfStrings(in.ShortNames)
+	att["categories"] = flattenListOfStrings(in.Categories)
+
+	return []interface{}{att}
+}
+
+func flattenCustomResourceDefinitionVersion(in apiextensionsv1.CustomResourceDefinitionVersion) (map[string]interface{}, error) {
+	att := make(map[string]interface{})
+
+	att["name"] = in.Name
+	att["served"] = in.Served
+	att["storage"] = in.Storage
+	att["deprecated"] = in.Deprecated
+	if in.DeprecationWarning != nil {
+		att["deprecation_warning"] = *in.DeprecationWarning
+	}
+
+	if in.Schema != nil && in.Schema.OpenAPIV3Schema != nil {
+		s, err := flattenOpenAPIV3Schema(in.Schema.OpenAPIV3Schema)
+		if err != nil {
+			return nil, err
+		}
+		att["schema"] = s
+	}
+
+	if in.Subresources != nil {
+		att["subresources"] = flattenCustomResourceSubresources(*in.Subresources)
+	}
+
+	columns := make([]interface{}, len(in.AdditionalPrinterColumns))
+	for i, c := range in.AdditionalPrinterColumns {
+		columns[i] = map[string]interface{}{
+			"name":        c.Name,
+			"type":        c.Type,
+			"format":      c.Format,
+			"description": c.Description,
+			"priority":    int(c.Priority),
+			"json_path":   c.JSONPath,
+		}
+	}
+	att["additional_printer_column"] = columns
+
+	return att, nil
+}
+
+func flattenOpenAPIV3Schema(in *apiextensionsv1.JSONSchemaProps) (string, error) {
+	out, err := json.Marshal(in)
+	if err != nil {
+		return "", fmt.Errorf("Failed to serialize OpenAPI v3 schema: %s", err)
+	}
+	return string(out), nil
+}
+
+func flattenCustomResourceSubresources(in apiextensionsv1.CustomResourceSubresources) []interface{} {
+	att := make(map[string]interface{})
+
+	att["status"] = in.Status != nil
+
+	if in.Scale != nil {
+		scale := map[string]interface{}{
+			"spec_replicas_path":   in.Scale.SpecReplicasPath,
+			"status_replicas_path": in.Scale.StatusReplicasPath,
+		}
+		if in.Scale.LabelSelectorPath != nil {
+			scale["label_selector_path"] = *in.Scale.LabelSelectorPath
+		}
+		att["scale"] = []interface{}{scale}
+	}
+
+	return []interface{}{att}
+}
+
+func flattenCustomResourceConversion(in apiextensionsv1.CustomResourceConversion) []interface{} {
+	att := make(map[string]interface{})
+
+	att["strategy"] = string(in.Strategy)
+
+	if in.Webhook != nil {
+		webhook := map[string]interface{}{
+			"conversion_review_versions": flattenListOfStrings(in.Webhook.ConversionReviewVersions),
+		}
+		if in.Webhook.ClientConfig != nil {
+			webhook["client_config"] = flattenCustomResourceWebhookClientConfig(*in.Webhook.ClientConfig)
+		}
+		att["webhook"] = []interface{}{webhook}
+	}
+
+	return []interface{}{att}
+}
+
+func flattenCustomResourceWebhookClientConfig(in apiextensionsv1.WebhookClientConfig) []interface{} {
+	att := map[string]interface{}{}
+
+	if len(in.CABundle) > 0 {
+		att["ca_bundle"] = string(in.CABundle)
+	}
+
+	if in.Service != nil {
+		service := map[string]interface{}{
+			"name":      in.Service.Name,
+			"namespace": in.Service.Namespace,
+		}
+		if in.Service.Path != nil {
+			service["path"] = *in.Service.Path
+		}
+		if in.Service.Port != nil {
+			service["port"] = int(*in.Service.Port)
+		}
+		att["service"] = []interface{}{service}
+	}
+
+	if in.URL != nil {
+		att["url"] = *in.URL
+	}
+
+	return []interface{}{att}
+}
+
+// Expanders
+
+func expandCustomResourceDefinitionSpec(l []interface{}) (apiextensionsv1.CustomResourceDefinitionSpec, error) {
+	obj := apiextensionsv1.CustomResourceDefinitionSpec{}
+
+	if len(l) == 0 || l[0] == nil {
+		return obj, nil
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.Group = in["group"].(string)
+	obj.Scope = apiextensionsv1.ResourceScope(in["scope"].(string))
+	obj.Names = expandCustomResourceDefinitionNames(in["names"].([]interface{}))
+
+	if v, ok := in["preserve_unknown_fields"].(bool); ok {
+		obj.PreserveUnknownFields = v
+	}
+
+	if v, ok := in["version"].([]interface{}); ok {
+		for _, version := range v {
+			if version == nil {
+				continue
+			}
+			ver, err := expandCustomResourceDefinitionVersion(version.(map[string]interface{}))
+			if err != nil {
+				return obj, err
+			}
+			obj.Versions = append(obj.Versions, ver)
+		}
+	}
+
+	if v, ok := in["conversion"].([]interface{}); ok && len(v) > 0 {
+		obj.Conversion = expandCustomResourceConversion(v)
+	}
+
+	return obj, nil
+}
+
+func expandCustomResourceDefinitionNames(l []interface{}) apiextensionsv1.CustomResourceDefinitionNames {
+	obj := apiextensionsv1.CustomResourceDefinitionNames{}
+
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.Plural = in["plural"].(string)
+	obj.Kind = in["kind"].(string)
+
+	if v, ok := in["singular"].(string); ok {
+		obj.Singular = v
+	}
+	if v, ok := in["list_kind"].(string); ok {
+		obj.ListKind = v
+	}
+	if v, ok := in["short_names"].([]interface{}); ok && len(v) > 0 {
+		obj.ShortNames = expandStringSlice(v)
+	}
+	if v, ok := in["categories"].([]interface{}); ok && len(v) > 0 {
+		obj.Categories = expandStringSlice(v)
+	}
+
+	return obj
+}
+
+func expandCustomResourceDefinitionVersion(in map[string]interface{}) (apiextensionsv1.CustomResourceDefinitionVersion, error) {
+	obj := apiextensionsv1.CustomResourceDefinitionVersion{
+		Name:    in["name"].(string),
+		Served:  in["served"].(bool),
+		Storage: in["storage"].(bool),
+	}
+
+	if v, ok := in["deprecated"].(bool); ok {
+		obj.Deprecated = v
+	}
+	if v, ok := in["deprecation_warning"].(string); ok && v != "" {
+		obj.DeprecationWarning = ptrToString(v)
+	}
+
+	if v, ok := in["schema"].(string); ok && v != "" {
+		props, err := expandOpenAPIV3Schema(v)
+		if err != nil {
+			return obj, err
+		}
+		obj.Schema = &apiextensionsv1.CustomResourceValidation{
+			OpenAPIV3Schema: props,
+		}
+	}
+
+	if v, ok := in["subresources"].([]interface{}); ok && len(v) > 0 {
+		obj.Subresources = expandCustomResourceSubresources(v)
+	}
+
+	if v, ok := in["additional_printer_column"].([]interface{}); ok {
+		for _, c := range v {
+			if c == nil {
+				continue
+			}
+			column := c.(map[string]interface{})
+			obj.AdditionalPrinterColumns = append(obj.AdditionalPrinterColumns, apiextensionsv1.CustomResourceColumnDefinition{
+				Name:        column["name"].(string),
+				Type:        column["type"].(string),
+				Format:      column["format"].(string),
+				Description: column["description"].(string),
+				Priority:    int32(column["priority"].(int)),
+				JSONPath:    column["json_path"].(string),
+			})
+		}
+	}
+
+	return obj, nil
+}
+
+func expandOpenAPIV3Schema(s string) (*apiextensionsv1.JSONSchemaProps, error) {
+	data, err := yaml.YAMLToJSON([]byte(s))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse OpenAPI v3 schema: %s", err)
+	}
+	props := &apiextensionsv1.JSONSchemaProps{}
+	if err := json.Unmarshal(data, props); err != nil {
+		return nil, fmt.Errorf("Failed to parse OpenAPI v3 schema: %s", err)
+	}
+	return props, nil
+}
+
+func expandCustomResourceSubresources(l []interface{}) *apiextensionsv1.CustomResourceSubresources {
+	obj := &apiextensionsv1.CustomResourceSubresources{}
+
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["status"].(bool); ok && v {
+		obj.Status = &apiextensionsv1.CustomResourceSubresourceStatus{}
+	}
+
+	if v, ok := in["scale"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		scale := v[0].(map[string]interface{})
+		obj.Scale = &apiextensionsv1.CustomResourceSubresourceScale{
+			SpecReplicasPath:   scale["spec_replicas_path"].(string),
+			StatusReplicasPath: scale["status_replicas_path"].(string),
+		}
+		if p, ok := scale["label_selector_path"].(string); ok && p != "" {
+			obj.Scale.LabelSelectorPath = ptrToString(p)
+		}
+	}
+
+	return obj
+}
+
+func expandCustomResourceConversion(l []interface{}) *apiextensionsv1.CustomResourceConversion {
+	obj := &apiextensionsv1.CustomResourceConversion{}
+
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["strategy"].(string); ok {
+		obj.Strategy = apiextensionsv1.ConversionStrategyType(v)
+	}
+
+	if v, ok := in["webhook"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		webhook := v[0].(map[string]interface{})
+		obj.Webhook = &apiextensionsv1.WebhookConversion{}
+		if versions, ok := webhook["conversion_review_versions"].([]interface{}); ok {
+			obj.Webhook.ConversionReviewVersions = expandStringSlice(versions)
+		}
+		if cc, ok := webhook["client_config"].([]interface{}); ok && len(cc) > 0 {
+			obj.Webhook.ClientConfig = expandCustomResourceWebhookClientConfig(cc)
+		}
+	}
+
+	return obj
+}
+
+// expandCustomResourceWebhookClientConfig reuses the admission webhook client
+// configuration, which shares its schema with the conversion webhook one.
+func expandCustomResourceWebhookClientConfig(l []interface{}) *apiextensionsv1.WebhookClientConfig {
+	cc := expandWebhookClientConfig(l)
+	obj := &apiextensionsv1.WebhookClientConfig{
+		URL:      cc.URL,
+		CABundle: cc.CABundle,
+	}
+	if cc.Service != nil {
+		obj.Service = &apiextensionsv1.ServiceReference{
+			Namespace: cc.Service.Namespace,
+			Name:      cc.Service.Name,
+			Path:      cc.Service.Path,
+			Port:      cc.Service.Port,
+		}
+	}
+	return obj
+}
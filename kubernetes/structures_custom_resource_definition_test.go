@@ -0,0 +1,123 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestCustomResourceDefinitionSpecRoundTrip(t *testing.T) {
+	in := apiextensionsv1.CustomResourceDefinitionSpec{
+		Group: "stable.example.com",
+		Scope: apiextensionsv1.NamespaceScoped,
+		Names: apiextensionsv1.CustomResourceDefinitionNames{
+			Plural:     "crontabs",
+			Singular:   "crontab",
+			Kind:       "CronTab",
+			ListKind:   "CronTabList",
+			ShortNames: []string{"ct"},
+		},
+		Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+			{
+				Name:    "v1",
+				Served:  true,
+				Storage: true,
+				Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						Type: "object",
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"spec": {
+								Type: "object",
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"cronSpec": {Type: "string"},
+									"replicas": {Type: "integer"},
+								},
+							},
+						},
+					},
+				},
+				Subresources: &apiextensionsv1.CustomResourceSubresources{
+					Status: &apiextensionsv1.CustomResourceSubresourceStatus{},
+					Scale: &apiextensionsv1.CustomResourceSubresourceScale{
+						SpecReplicasPath:   ".spec.replicas",
+						StatusReplicasPath: ".status.replicas",
+						LabelSelectorPath:  pointer.StringPtr(".status.labelSelector"),
+					},
+				},
+				AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+					{Name: "Spec", Type: "string", JSONPath: ".spec.cronSpec"},
+				},
+			},
+			{
+				Name:               "v1beta1",
+				Served:             true,
+				Deprecated:         true,
+				DeprecationWarning: pointer.StringPtr("stable.example.com/v1beta1 CronTab is deprecated"),
+			},
+		},
+		Conversion: &apiextensionsv1.CustomResourceConversion{
+			Strategy: apiextensionsv1.WebhookConverter,
+			Webhook: &apiextensionsv1.WebhookConversion{
+				ConversionReviewVersions: []string{"v1"},
+				ClientConfig: &apiextensionsv1.WebhookClientConfig{
+					URL: pointer.StringPtr("https://example.com/convert"),
+				},
+			},
+		},
+	}
+
+	flattened, err := flattenCustomResourceDefinitionSpec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := expandCustomResourceDefinitionSpec(flattened)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("Round trip mismatch.\nExpected: %#v\nGot:      %#v", in, out)
+	}
+}
+
+func TestSuppressEquivalentOpenAPIV3Schema(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			"json and yaml",
+			`{"type":"object","properties":{"spec":{"type":"string"}}}`,
+			"type: object\nproperties:\n  spec:\n    type: string\n",
+			true,
+		},
+		{
+			"key order",
+			`{"type":"object","properties":{"a":{"type":"string"},"b":{"type":"integer"}}}`,
+			`{"properties":{"b":{"type":"integer"},"a":{"type":"string"}},"type":"object"}`,
+			true,
+		},
+		{
+			"different type",
+			`{"type":"object","properties":{"spec":{"type":"string"}}}`,
+			`{"type":"object","properties":{"spec":{"type":"integer"}}}`,
+			false,
+		},
+		{
+			"invalid",
+			`{"type":"object"}`,
+			`type: [`,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if suppressEquivalentOpenAPIV3Schema("", tc.Old, tc.New, nil) != tc.Suppress {
+				t.Fatalf("Expected suppress to be %t for %q and %q", tc.Suppress, tc.Old, tc.New)
+			}
+		})
+	}
+}
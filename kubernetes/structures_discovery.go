@@ -0,0 +1,84 @@
+package kubernetes
+
+import (
+	"strings"
+
+	gversion "github.com/hashicorp/go-version"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+func flattenServerVersion(in *version.Info) map[string]interface{} {
+	att := map[string]interface{}{
+		"major":       in.Major,
+		"minor":       in.Minor,
+		"version":     "",
+		"git_version": in.GitVersion,
+		"git_commit":  in.GitCommit,
+		"build_date":  in.BuildDate,
+		"go_version":  in.GoVersion,
+		"platform":    in.Platform,
+	}
+	if v, err := gversion.NewVersion(in.GitVersion); err == nil {
+		att["version"] = v.Core().String()
+	}
+	return att
+}
+
+// apiGroupMatches reports whether the group is selected by the filter of the
+// kubernetes_api_resources data source, where `core` selects the core group.
+func apiGroupMatches(filter, group string) bool {
+	return filter == "" || filter == group || (filter == "core" && group == "")
+}
+
+func flattenAPIGroups(in []*metav1.APIGroup, filter string) ([]string, []interface{}) {
+	apiVersions := []string{}
+	groups := []interface{}{}
+	for _, g := range in {
+		if !apiGroupMatches(filter, g.Name) {
+			continue
+		}
+		versions := make([]string, len(g.Versions))
+		for i, v := range g.Versions {
+			versions[i] = v.Version
+			apiVersions = append(apiVersions, v.GroupVersion)
+		}
+		groups = append(groups, map[string]interface{}{
+			"name":              g.Name,
+			"versions":          versions,
+			"preferred_version": g.PreferredVersion.Version,
+		})
+	}
+	return apiVersions, groups
+}
+
+func flattenAPIResources(in []*metav1.APIResourceList, filter string) []interface{} {
+	resources := []interface{}{}
+	for _, list := range in {
+		gv, err := apimachineryschema.ParseGroupVersion(list.GroupVersion)
+		if err != nil || !apiGroupMatches(filter, gv.Group) {
+			continue
+		}
+		for _, r := range list.APIResources {
+			if strings.Contains(r.Name, "/") {
+				continue
+			}
+			shortNames := r.ShortNames
+			if shortNames == nil {
+				shortNames = []string{}
+			}
+			resources = append(resources, map[string]interface{}{
+				"api_version": list.GroupVersion,
+				"group":       gv.Group,
+				"version":     gv.Version,
+				"name":        r.Name,
+				"kind":        r.Kind,
+				"namespaced":  r.Namespaced,
+				"verbs":       []string(r.Verbs),
+				"short_names": shortNames,
+			})
+		}
+	}
+	return resources
+}
@@ -0,0 +1,103 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+func TestFlattenServerVersion(t *testing.T) {
+	v := flattenServerVersion(&version.Info{
+		Major:      "1",
+		Minor:      "21+",
+		GitVersion: "v1.21.2-eks-0389ca3",
+		Platform:   "linux/amd64",
+	})
+	if v["version"] != "1.21.2" {
+		t.Fatalf("Expected version 1.21.2, got %q", v["version"])
+	}
+	if v["minor"] != "21+" || v["platform"] != "linux/amd64" {
+		t.Fatalf("Unexpected server version: %#v", v)
+	}
+}
+
+func TestFlattenAPIResources(t *testing.T) {
+	groups := []*metav1.APIGroup{
+		{
+			Name:             "",
+			Versions:         []metav1.GroupVersionForDiscovery{{GroupVersion: "v1", Version: "v1"}},
+			PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "v1", Version: "v1"},
+		},
+		{
+			Name: "networking.k8s.io",
+			Versions: []metav1.GroupVersionForDiscovery{
+				{GroupVersion: "networking.k8s.io/v1", Version: "v1"},
+				{GroupVersion: "networking.k8s.io/v1beta1", Version: "v1beta1"},
+			},
+			PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "networking.k8s.io/v1", Version: "v1"},
+		},
+	}
+	lists := []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list"}, ShortNames: []string{"po"}},
+				{Name: "pods/status", Kind: "Pod", Namespaced: true, Verbs: []string{"get"}},
+			},
+		},
+		{
+			GroupVersion: "networking.k8s.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "ingresses", Kind: "Ingress", Namespaced: true, Verbs: []string{"get", "list"}, ShortNames: []string{"ing"}},
+				{Name: "ingressclasses", Kind: "IngressClass", Verbs: []string{"get"}},
+			},
+		},
+	}
+
+	apiVersions, flattenedGroups := flattenAPIGroups(groups, "")
+	expectedVersions := []string{"v1", "networking.k8s.io/v1", "networking.k8s.io/v1beta1"}
+	if !reflect.DeepEqual(apiVersions, expectedVersions) {
+		t.Fatalf("Expected API versions %v, got %v", expectedVersions, apiVersions)
+	}
+	if len(flattenedGroups) != 2 {
+		t.Fatalf("Expected 2 groups, got %d", len(flattenedGroups))
+	}
+
+	coreVersions, _ := flattenAPIGroups(groups, "core")
+	if !reflect.DeepEqual(coreVersions, []string{"v1"}) {
+		t.Fatalf("Expected only the core group, got %v", coreVersions)
+	}
+
+	resources := flattenAPIResources(lists, "networking.k8s.io")
+	expected := []interface{}{
+		map[string]interface{}{
+			"api_version": "networking.k8s.io/v1",
+			"group":       "networking.k8s.io",
+			"version":     "v1",
+			"name":        "ingresses",
+			"kind":        "Ingress",
+			"namespaced":  true,
+			"verbs":       []string{"get", "list"},
+			"short_names": []string{"ing"},
+		},
+		map[string]interface{}{
+			"api_version": "networking.k8s.io/v1",
+			"group":       "networking.k8s.io",
+			"version":     "v1",
+			"name":        "ingressclasses",
+			"kind":        "IngressClass",
+			"namespaced":  false,
+			"verbs":       []string{"get"},
+			"short_names": []string{},
+		},
+	}
+	if !reflect.DeepEqual(resources, expected) {
+		t.Fatalf("Expected resources:\n%#v\ngot:\n%#v", expected, resources)
+	}
+
+	if all := flattenAPIResources(lists, ""); len(all) != 3 {
+		t.Fatalf("Expected the subresources to be left out, got %d resources", len(all))
+	}
+}
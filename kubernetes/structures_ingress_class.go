@@ -0,0 +1,92 @@
+package kubernetes
+
+import (
+	networking "k8s.io/api/networking/v1"
+)
+
+// ingressClassDefaultAnnotation marks the ingress class assigned to ingresses which don't specify one
+const ingressClassDefaultAnnotation = "ingressclass.kubernetes.io/is-default-class"
+
+// Flatteners
+
+func flattenIngressClassSpec(in networking.IngressClassSpec) []interface{} {
+	att := make(map[string]interface{})
+
+	att["controller"] = in.Controller
+
+	if in.Parameters != nil {
+		att["parameters"] = flattenIngressClassParameters(in.Parameters)
+	}
+
+	return []interface{}{att}
+}
+
+func flattenIngressClassParameters(in *networking.IngressClassParametersReference) []interface{} {
+	att := make(map[string]interface{})
+
+	att["kind"] = in.Kind
+	att["name"] = in.Name
+
+	if in.APIGroup != nil {
+		att["api_group"] = *in.APIGroup
+	}
+	if in.Scope != nil {
+		att["scope"] = *in.Scope
+	}
+	if in.Namespace != nil {
+		att["namespace"] = *in.Namespace
+	}
+
+	return []interface{}{att}
+}
+
+func flattenIngressClassDefault(in networking.IngressClass) bool {
+	return in.Annotations[ingressClassDefaultAnnotation] == "true"
+}
+
+// Expanders
+
+func expandIngressClassSpec(l []interface{}) networking.IngressClassSpec {
+	obj := networking.IngressClassSpec{}
+
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["controller"].(string); ok {
+		obj.Controller = v
+	}
+
+	if v, ok := in["parameters"].([]interface{}); ok && len(v) > 0 {
+		obj.Parameters = expandIngressClassParameters(v)
+	}
+
+	return obj
+}
+
+func expandIngressClassParameters(l []interface{}) *networking.IngressClassParametersReference {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	in := l[0].(map[string]interface{})
+	obj := &networking.IngressClassParametersReference{}
+
+	if v, ok := in["kind"].(string); ok {
+		obj.Kind = v
+	}
+	if v, ok := in["name"].(string); ok {
+		obj.Name = v
+	}
+	if v, ok := in["api_group"].(string); ok && v != "" {
+		obj.APIGroup = &v
+	}
+	if v, ok := in["scope"].(string); ok && v != "" {
+		obj.Scope = &v
+	}
+	if v, ok := in["namespace"].(string); ok && v != "" {
+		obj.Namespace = &v
+	}
+
+	return obj
+}
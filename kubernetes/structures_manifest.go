@@ -0,0 +1,164 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"sigs.k8s.io/yaml"
+)
+
+// serverManagedMetadataFields lists the metadata keys populated by the API server,
+// which are dropped when an object is imported into a manifest.
+var serverManagedMetadataFields = []string{
+	"creationTimestamp",
+	"deletionGracePeriodSeconds",
+	"deletionTimestamp",
+	"generation",
+	"managedFields",
+	"resourceVersion",
+	"selfLink",
+	"uid",
+}
+
+func expandManifest(manifest string) (*unstructured.Unstructured, error) {
+	data, err := yaml.YAMLToJSON([]byte(manifest))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse manifest: %s", err)
+	}
+	m := map[string]interface{}{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("Failed to parse manifest: %s", err)
+	}
+	obj := &unstructured.Unstructured{Object: m}
+	if obj.GetAPIVersion() == "" {
+		return nil, fmt.Errorf("Manifest is missing `apiVersion`")
+	}
+	if obj.GetKind() == "" {
+		return nil, fmt.Errorf("Manifest is missing `kind`")
+	}
+	if obj.GetName() == "" {
+		return nil, fmt.Errorf("Manifest is missing `metadata.name`")
+	}
+	return obj, nil
+}
+
+func flattenManifest(obj map[string]interface{}) (string, error) {
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("Failed to serialize manifest: %s", err)
+	}
+	return string(out), nil
+}
+
+// normalizeManifestObject round-trips an object through JSON so that values
+// decoded from YAML and values received from the API server compare equal.
+func normalizeManifestObject(obj map[string]interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]interface{}{}
+	err = json.Unmarshal(data, &out)
+	return out, err
+}
+
+// projectManifest returns the subset of the live object made of the fields
+// present in the configured manifest, so that a plan only shows drift
+// on the fields managed by Terraform.
+func projectManifest(config, live interface{}) interface{} {
+	switch c := config.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return live
+		}
+		out := make(map[string]interface{}, len(c))
+		for k, v := range c {
+			if lv, ok := l[k]; ok {
+				out[k] = projectManifest(v, lv)
+			}
+		}
+		return out
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(c) {
+			return live
+		}
+		out := make([]interface{}, len(l))
+		for i := range l {
+			out[i] = projectManifest(c[i], l[i])
+		}
+		return out
+	default:
+		return live
+	}
+}
+
+func removeServerManagedFields(obj *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(obj.Object, "status")
+	for _, f := range serverManagedMetadataFields {
+		unstructured.RemoveNestedField(obj.Object, "metadata", f)
+	}
+	annotations := obj.GetAnnotations()
+	delete(annotations, "kubectl.kubernetes.io/last-applied-configuration")
+	if len(annotations) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+	} else {
+		obj.SetAnnotations(annotations)
+	}
+}
+
+func buildManifestId(obj *unstructured.Unstructured) string {
+	return strings.Join([]string{obj.GetAPIVersion(), obj.GetKind(), obj.GetNamespace(), obj.GetName()}, "/")
+}
+
+func manifestIdParts(id string) (apimachineryschema.GroupVersionKind, string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) < 4 || len(parts) > 5 {
+		err := fmt.Errorf("Unexpected ID format (%q), expected %q.", id, "apiVersion/kind/namespace/name")
+		return apimachineryschema.GroupVersionKind{}, "", "", err
+	}
+	n := len(parts)
+	gv, err := apimachineryschema.ParseGroupVersion(strings.Join(parts[:n-3], "/"))
+	if err != nil {
+		return apimachineryschema.GroupVersionKind{}, "", "", err
+	}
+	return gv.WithKind(parts[n-3]), parts[n-2], parts[n-1], nil
+}
+
+// manifestResourceClient maps the kind of the object onto its API resource
+// and returns a dynamic client scoped to the object namespace, defaulting it
+// for namespaced kinds. The discovery cache is refreshed once on a miss,
+// as the kind may have been registered by a CRD created in the same run.
+func manifestResourceClient(dc dynamic.Interface, mapper *restmapper.DeferredDiscoveryRESTMapper, gvk apimachineryschema.GroupVersionKind, namespace string) (dynamic.ResourceInterface, string, error) {
+	mapping, err := manifestResourceMapping(mapper, gvk)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return dc.Resource(mapping.Resource), "", nil
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+	return dc.Resource(mapping.Resource).Namespace(namespace), namespace, nil
+}
+
+func manifestResourceMapping(mapper *restmapper.DeferredDiscoveryRESTMapper, gvk apimachineryschema.GroupVersionKind) (*meta.RESTMapping, error) {
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		mapper.Reset()
+		mapping, err = mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to find API resource for %s: %s", gvk, err)
+	}
+	return mapping, nil
+}
@@ -0,0 +1,111 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestManifestIdParts(t *testing.T) {
+	testCases := []struct {
+		Id        string
+		Group     string
+		Version   string
+		Kind      string
+		Namespace string
+		Name      string
+		ExpectErr bool
+	}{
+		{"v1/ConfigMap/default/foo", "", "v1", "ConfigMap", "default", "foo", false},
+		{"apps/v1/Deployment/kube-system/bar", "apps", "v1", "Deployment", "kube-system", "bar", false},
+		{"rbac.authorization.k8s.io/v1/ClusterRole//baz", "rbac.authorization.k8s.io", "v1", "ClusterRole", "", "baz", false},
+		{"default/foo", "", "", "", "", "", true},
+		{"a/b/c/v1/Kind/ns/name", "", "", "", "", "", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Id, func(t *testing.T) {
+			gvk, namespace, name, err := manifestIdParts(tc.Id)
+			if tc.ExpectErr {
+				if err == nil {
+					t.Fatalf("Expected an error for %q", tc.Id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gvk.Group != tc.Group || gvk.Version != tc.Version || gvk.Kind != tc.Kind {
+				t.Fatalf("Unexpected group version kind: %s", gvk)
+			}
+			if namespace != tc.Namespace || name != tc.Name {
+				t.Fatalf("Unexpected namespace/name: %s/%s", namespace, name)
+			}
+		})
+	}
+}
+
+func TestProjectManifest(t *testing.T) {
+	config := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name": "foo",
+		},
+		"data": map[string]interface{}{
+			"one": "1",
+			"two": "2",
+		},
+		"list": []interface{}{
+			map[string]interface{}{"name": "a"},
+		},
+	}
+	live := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "foo",
+			"namespace":       "default",
+			"resourceVersion": "123",
+		},
+		"data": map[string]interface{}{
+			"one": "changed",
+		},
+		"list": []interface{}{
+			map[string]interface{}{"name": "a", "defaulted": true},
+		},
+	}
+	expected := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name": "foo",
+		},
+		"data": map[string]interface{}{
+			"one": "changed",
+		},
+		"list": []interface{}{
+			map[string]interface{}{"name": "a"},
+		},
+	}
+
+	out := projectManifest(config, live)
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Unexpected projection:\n%#v\nexpected:\n%#v", out, expected)
+	}
+}
+
+func TestSuppressEquivalentManifest(t *testing.T) {
+	yamlManifest := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo
+data:
+  one: "1"
+`
+	jsonManifest := `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"foo"},"data":{"one":"1"}}`
+	if !suppressEquivalentManifest("manifest", yamlManifest, jsonManifest, nil) {
+		t.Fatal("Expected YAML and JSON manifests to be equivalent")
+	}
+	if suppressEquivalentManifest("manifest", yamlManifest, `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"bar"}}`, nil) {
+		t.Fatal("Expected manifests to differ")
+	}
+}
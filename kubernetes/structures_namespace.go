@@ -0,0 +1,66 @@
+package kubernetes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	api "k8s.io/api/core/v1"
+)
+
+// namespaceRemainingObject is an object still present in a namespace
+// which is being terminated.
+type namespaceRemainingObject struct {
+	Resource   string
+	Name       string
+	Finalizers []string
+}
+
+// maxNamespaceRemainingObjects caps the number of remaining objects listed
+// in the diagnostics of a namespace stuck in termination.
+const maxNamespaceRemainingObjects = 20
+
+// describeNamespaceTermination explains why a namespace is still terminating,
+// based on its conditions and the objects found in it.
+func describeNamespaceTermination(ns *api.Namespace, remaining []namespaceRemainingObject) string {
+	var lines []string
+	for _, c := range ns.Status.Conditions {
+		if c.Status != api.ConditionTrue {
+			continue
+		}
+		line := fmt.Sprintf("%s (%s)", c.Type, c.Reason)
+		if c.Message != "" {
+			line += ": " + c.Message
+		}
+		lines = append(lines, line)
+	}
+	if len(ns.Spec.Finalizers) > 0 {
+		fs := make([]string, len(ns.Spec.Finalizers))
+		for i, f := range ns.Spec.Finalizers {
+			fs[i] = string(f)
+		}
+		lines = append(lines, fmt.Sprintf("Namespace finalizers: %s", strings.Join(fs, ", ")))
+	}
+
+	sort.Slice(remaining, func(i, j int) bool {
+		if remaining[i].Resource != remaining[j].Resource {
+			return remaining[i].Resource < remaining[j].Resource
+		}
+		return remaining[i].Name < remaining[j].Name
+	})
+	if len(remaining) > 0 {
+		lines = append(lines, "Remaining resources:")
+	}
+	for i, o := range remaining {
+		if i == maxNamespaceRemainingObjects {
+			lines = append(lines, fmt.Sprintf("  ... and %d more", len(remaining)-i))
+			break
+		}
+		line := fmt.Sprintf("  %s/%s", o.Resource, o.Name)
+		if len(o.Finalizers) > 0 {
+			line += fmt.Sprintf(" (finalizers: %s)", strings.Join(o.Finalizers, ", "))
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,67 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	api "k8s.io/api/core/v1"
+)
+
+func TestDescribeNamespaceTermination(t *testing.T) {
+	ns := &api.Namespace{
+		Spec: api.NamespaceSpec{
+			Finalizers: []api.FinalizerName{api.FinalizerKubernetes},
+		},
+		Status: api.NamespaceStatus{
+			Phase: api.NamespaceTerminating,
+			Conditions: []api.NamespaceCondition{
+				{
+					Type:   api.NamespaceDeletionDiscoveryFailure,
+					Status: api.ConditionFalse,
+					Reason: "ResourcesDiscovered",
+				},
+				{
+					Type:    api.NamespaceContentRemaining,
+					Status:  api.ConditionTrue,
+					Reason:  "SomeResourcesRemain",
+					Message: "Some resources are remaining: configmaps. has 1 resource instances",
+				},
+				{
+					Type:    api.NamespaceFinalizersRemaining,
+					Status:  api.ConditionTrue,
+					Reason:  "SomeFinalizersRemain",
+					Message: "Some content in the namespace has finalizers remaining: example.com/block in 1 resource instances",
+				},
+			},
+		},
+	}
+	remaining := []namespaceRemainingObject{
+		{Resource: "secrets", Name: "token"},
+		{Resource: "configmaps", Name: "blocked", Finalizers: []string{"example.com/block"}},
+	}
+
+	expected := strings.Join([]string{
+		"NamespaceContentRemaining (SomeResourcesRemain): Some resources are remaining: configmaps. has 1 resource instances",
+		"NamespaceFinalizersRemaining (SomeFinalizersRemain): Some content in the namespace has finalizers remaining: example.com/block in 1 resource instances",
+		"Namespace finalizers: kubernetes",
+		"Remaining resources:",
+		"  configmaps/blocked (finalizers: example.com/block)",
+		"  secrets/token",
+	}, "\n")
+	if out := describeNamespaceTermination(ns, remaining); out != expected {
+		t.Fatalf("Unexpected description.\nExpected:\n%s\nGiven:\n%s", expected, out)
+	}
+}
+
+func TestDescribeNamespaceTerminationTruncated(t *testing.T) {
+	remaining := make([]namespaceRemainingObject, maxNamespaceRemainingObjects+5)
+	for i := range remaining {
+		remaining[i] = namespaceRemainingObject{Resource: "pods", Name: fmt.Sprintf("pod-%02d", i)}
+	}
+
+	out := describeNamespaceTermination(&api.Namespace{}, remaining)
+	if !strings.HasSuffix(out, "  ... and 5 more") {
+		t.Fatalf("Expected the list of remaining resources to be truncated, got:\n%s", out)
+	}
+}
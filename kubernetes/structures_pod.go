@@ -93,12 +93,27 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	if len(in.NodeSelector) > 0 {
 		att["node_selector"] = in.NodeSelector
 	}
+	if in.OS != nil {
+		att["os"] = flattenPodOS(in.OS)
+	}
+	if len(in.Overhead) > 0 {
+		att["overhead"] = flattenResourceList(in.Overhead)
+	}
+	if in.PreemptionPolicy != nil {
+		att["preemption_policy"] = string(*in.PreemptionPolicy)
+	}
 	if in.PriorityClassName != "" {
 		att["priority_class_name"] = in.PriorityClassName
 	}
 	if in.RestartPolicy != "" {
 		att["restart_policy"] = in.RestartPolicy
 	}
+	if in.RuntimeClassName != nil {
+		att["runtime_class_name"] = *in.RuntimeClassName
+	}
+	if in.SchedulerName != "" {
+		att["scheduler_name"] = in.SchedulerName
+	}
 
 	if in.SecurityContext != nil {
 		att["security_context"] = flattenPodSecurityContext(in.SecurityContext)
@@ -107,6 +122,9 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	if in.ServiceAccountName != "" {
 		att["service_account_name"] = in.ServiceAccountName
 	}
+	if in.SetHostnameAsFQDN != nil {
+		att["set_hostname_as_fqdn"] = *in.SetHostnameAsFQDN
+	}
 	if in.ShareProcessNamespace != nil {
 		att["share_process_namespace"] = *in.ShareProcessNamespace
 	}
@@ -193,6 +211,12 @@ func flattenPodDNSConfigOptions(options []v1.PodDNSConfigOption) ([]interface{},
 	return att, nil
 }
 
+func flattenPodOS(in *v1.PodOS) []interface{} {
+	att := make(map[string]interface{})
+	att["name"] = string(in.Name)
+	return []interface{}{att}
+}
+
 func flattenPodSecurityContext(in *v1.PodSecurityContext) []interface{} {
 	att := make(map[string]interface{})
 
@@ -216,9 +240,15 @@ func flattenPodSecurityContext(in *v1.PodSecurityContext) []interface{} {
 	if in.SELinuxOptions != nil {
 		att["se_linux_options"] = flattenSeLinuxOptions(in.SELinuxOptions)
 	}
+	if in.SeccompProfile != nil {
+		att["seccomp_profile"] = flattenSeccompProfile(in.SeccompProfile)
+	}
 	if in.Sysctls != nil {
 		att["sysctl"] = flattenSysctls(in.Sysctls)
 	}
+	if in.WindowsOptions != nil {
+		att["windows_options"] = flattenWindowsOptions(in.WindowsOptions)
+	}
 
 	if len(att) > 0 {
 		return []interface{}{att}
@@ -243,6 +273,29 @@ func flattenSeLinuxOptions(in *v1.SELinuxOptions) []interface{} {
 	return []interface{}{att}
 }
 
+func flattenSeccompProfile(in *v1.SeccompProfile) []interface{} {
+	att := make(map[string]interface{})
+	att["type"] = string(in.Type)
+	if in.LocalhostProfile != nil {
+		att["localhost_profile"] = *in.LocalhostProfile
+	}
+	return []interface{}{att}
+}
+
+func flattenWindowsOptions(in *v1.WindowsSecurityContextOptions) []interface{} {
+	att := make(map[string]interface{})
+	if in.GMSACredentialSpec != nil {
+		att["gmsa_credential_spec"] = *in.GMSACredentialSpec
+	}
+	if in.GMSACredentialSpecName != nil {
+		att["gmsa_credential_spec_name"] = *in.GMSACredentialSpecName
+	}
+	if in.RunAsUserName != nil {
+		att["run_as_username"] = *in.RunAsUserName
+	}
+	return []interface{}{att}
+}
+
 func flattenSysctls(sysctls []v1.Sysctl) []interface{} {
 	att := []interface{}{}
 	for _, v := range sysctls {
@@ -391,6 +444,12 @@ func flattenVolumes(volumes []v1.Volume) ([]interface{}, error) {
 		if v.PhotonPersistentDisk != nil {
 			obj["photon_persistent_disk"] = flattenPhotonPersistentDiskVolumeSource(v.PhotonPersistentDisk)
 		}
+		if v.CSI != nil {
+			obj["csi"] = flattenCSIInlineVolumeSource(v.CSI)
+		}
+		if v.Ephemeral != nil {
+			obj["ephemeral"] = flattenEphemeralVolumeSource(v.Ephemeral)
+		}
 		att[i] = obj
 	}
 	return att, nil
@@ -615,6 +674,45 @@ func flattenServiceAccountTokenProjection(in *v1.ServiceAccountTokenProjection)
 	return []interface{}{att}
 }
 
+func flattenCSIInlineVolumeSource(in *v1.CSIVolumeSource) []interface{} {
+	att := make(map[string]interface{})
+	att["driver"] = in.Driver
+	if in.FSType != nil {
+		att["fs_type"] = *in.FSType
+	}
+	if in.NodePublishSecretRef != nil {
+		att["node_publish_secret_ref"] = flattenLocalObjectReference(in.NodePublishSecretRef)
+	}
+	if in.ReadOnly != nil {
+		att["read_only"] = *in.ReadOnly
+	}
+	if len(in.VolumeAttributes) > 0 {
+		att["volume_attributes"] = in.VolumeAttributes
+	}
+	return []interface{}{att}
+}
+
+func flattenEphemeralVolumeSource(in *v1.EphemeralVolumeSource) []interface{} {
+	att := make(map[string]interface{})
+	if in.VolumeClaimTemplate != nil {
+		tpl := map[string]interface{}{
+			"spec": flattenPersistentVolumeClaimSpec(in.VolumeClaimTemplate.Spec),
+		}
+		meta := make(map[string]interface{})
+		if len(in.VolumeClaimTemplate.Annotations) > 0 {
+			meta["annotations"] = in.VolumeClaimTemplate.Annotations
+		}
+		if len(in.VolumeClaimTemplate.Labels) > 0 {
+			meta["labels"] = in.VolumeClaimTemplate.Labels
+		}
+		if len(meta) > 0 {
+			tpl["metadata"] = []interface{}{meta}
+		}
+		att["volume_claim_template"] = []interface{}{tpl}
+	}
+	return []interface{}{att}
+}
+
 func flattenReadinessGates(in []v1.PodReadinessGate) ([]interface{}, error) {
 	att := make([]interface{}, len(in))
 	for i, v := range in {
@@ -733,6 +831,23 @@ func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
 		obj.NodeSelector = nodeSelectors
 	}
 
+	if v, ok := in["os"].([]interface{}); ok && len(v) > 0 {
+		obj.OS = expandPodOS(v)
+	}
+
+	if v, ok := in["overhead"].(map[string]interface{}); ok && len(v) > 0 {
+		rl, err := expandMapToResourceList(v)
+		if err != nil {
+			return obj, err
+		}
+		obj.Overhead = *rl
+	}
+
+	if v, ok := in["preemption_policy"].(string); ok && v != "" {
+		policy := v1.PreemptionPolicy(v)
+		obj.PreemptionPolicy = &policy
+	}
+
 	if v, ok := in["priority_class_name"].(string); ok {
 		obj.PriorityClassName = v
 	}
@@ -741,6 +856,14 @@ func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
 		obj.RestartPolicy = v1.RestartPolicy(v)
 	}
 
+	if v, ok := in["runtime_class_name"].(string); ok && v != "" {
+		obj.RuntimeClassName = ptrToString(v)
+	}
+
+	if v, ok := in["scheduler_name"].(string); ok {
+		obj.SchedulerName = v
+	}
+
 	if v, ok := in["security_context"].([]interface{}); ok && len(v) > 0 {
 		ctx, err := expandPodSecurityContext(v)
 		if err != nil {
@@ -753,6 +876,10 @@ func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
 		obj.ServiceAccountName = v
 	}
 
+	if v, ok := in["set_hostname_as_fqdn"].(bool); ok && v {
+		obj.SetHostnameAsFQDN = ptrToBool(v)
+	}
+
 	if v, ok := in["share_process_namespace"]; ok {
 		obj.ShareProcessNamespace = ptrToBool(v.(bool))
 	}
@@ -838,6 +965,18 @@ func expandDNSConfigOptions(options []interface{}) ([]v1.PodDNSConfigOption, err
 	return opts, nil
 }
 
+func expandPodOS(l []interface{}) *v1.PodOS {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	in := l[0].(map[string]interface{})
+	obj := &v1.PodOS{}
+	if v, ok := in["name"].(string); ok {
+		obj.Name = v1.OSName(v)
+	}
+	return obj
+}
+
 func expandPodSecurityContext(l []interface{}) (*v1.PodSecurityContext, error) {
 	obj := &v1.PodSecurityContext{}
 	if len(l) == 0 || l[0] == nil {
@@ -871,12 +1010,18 @@ func expandPodSecurityContext(l []interface{}) (*v1.PodSecurityContext, error) {
 	if v, ok := in["se_linux_options"].([]interface{}); ok && len(v) > 0 {
 		obj.SELinuxOptions = expandSeLinuxOptions(v)
 	}
+	if v, ok := in["seccomp_profile"].([]interface{}); ok && len(v) > 0 {
+		obj.SeccompProfile = expandSeccompProfile(v)
+	}
 	if v, ok := in["supplemental_groups"].(*schema.Set); ok {
 		obj.SupplementalGroups = schemaSetToInt64Array(v)
 	}
 	if v, ok := in["sysctl"].([]interface{}); ok && len(v) > 0 {
 		obj.Sysctls = expandSysctls(v)
 	}
+	if v, ok := in["windows_options"].([]interface{}); ok && len(v) > 0 {
+		obj.WindowsOptions = expandWindowsOptions(v)
+	}
 
 	return obj, nil
 }
@@ -920,6 +1065,39 @@ func expandSeLinuxOptions(l []interface{}) *v1.SELinuxOptions {
 	return obj
 }
 
+func expandSeccompProfile(l []interface{}) *v1.SeccompProfile {
+	if len(l) == 0 || l[0] == nil {
+		return &v1.SeccompProfile{}
+	}
+	in := l[0].(map[string]interface{})
+	obj := &v1.SeccompProfile{}
+	if v, ok := in["type"].(string); ok {
+		obj.Type = v1.SeccompProfileType(v)
+	}
+	if v, ok := in["localhost_profile"].(string); ok && v != "" {
+		obj.LocalhostProfile = ptrToString(v)
+	}
+	return obj
+}
+
+func expandWindowsOptions(l []interface{}) *v1.WindowsSecurityContextOptions {
+	if len(l) == 0 || l[0] == nil {
+		return &v1.WindowsSecurityContextOptions{}
+	}
+	in := l[0].(map[string]interface{})
+	obj := &v1.WindowsSecurityContextOptions{}
+	if v, ok := in["gmsa_credential_spec"].(string); ok && v != "" {
+		obj.GMSACredentialSpec = ptrToString(v)
+	}
+	if v, ok := in["gmsa_credential_spec_name"].(string); ok && v != "" {
+		obj.GMSACredentialSpecName = ptrToString(v)
+	}
+	if v, ok := in["run_as_username"].(string); ok && v != "" {
+		obj.RunAsUserName = ptrToString(v)
+	}
+	return obj
+}
+
 func expandKeyPath(in []interface{}) []v1.KeyToPath {
 	if len(in) == 0 {
 		return []v1.KeyToPath{}
@@ -1449,10 +1627,70 @@ func expandVolumes(volumes []interface{}) ([]v1.Volume, error) {
 		if v, ok := m["photon_persistent_disk"].([]interface{}); ok && len(v) > 0 {
 			vl[i].PhotonPersistentDisk = expandPhotonPersistentDiskVolumeSource(v)
 		}
+		if v, ok := m["csi"].([]interface{}); ok && len(v) > 0 {
+			vl[i].CSI = expandCSIInlineVolumeSource(v)
+		}
+		if v, ok := m["ephemeral"].([]interface{}); ok && len(v) > 0 {
+			eph, err := expandEphemeralVolumeSource(v)
+			if err != nil {
+				return vl, err
+			}
+			vl[i].Ephemeral = eph
+		}
 	}
 	return vl, nil
 }
 
+func expandCSIInlineVolumeSource(l []interface{}) *v1.CSIVolumeSource {
+	obj := &v1.CSIVolumeSource{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	obj.Driver = in["driver"].(string)
+	if v, ok := in["fs_type"].(string); ok && v != "" {
+		obj.FSType = ptrToString(v)
+	}
+	if v, ok := in["node_publish_secret_ref"].([]interface{}); ok && len(v) > 0 {
+		obj.NodePublishSecretRef = expandLocalObjectReference(v)
+	}
+	if v, ok := in["read_only"].(bool); ok && v {
+		obj.ReadOnly = ptrToBool(v)
+	}
+	if v, ok := in["volume_attributes"].(map[string]interface{}); ok && len(v) > 0 {
+		obj.VolumeAttributes = expandStringMap(v)
+	}
+	return obj
+}
+
+func expandEphemeralVolumeSource(l []interface{}) (*v1.EphemeralVolumeSource, error) {
+	obj := &v1.EphemeralVolumeSource{}
+	if len(l) == 0 || l[0] == nil {
+		return obj, nil
+	}
+	in := l[0].(map[string]interface{})
+	t, ok := in["volume_claim_template"].([]interface{})
+	if !ok || len(t) == 0 || t[0] == nil {
+		return obj, nil
+	}
+	tpl := t[0].(map[string]interface{})
+	spec, err := expandPersistentVolumeClaimSpec(tpl["spec"].([]interface{}))
+	if err != nil {
+		return obj, err
+	}
+	obj.VolumeClaimTemplate = &v1.PersistentVolumeClaimTemplate{Spec: *spec}
+	if m, ok := tpl["metadata"].([]interface{}); ok && len(m) > 0 && m[0] != nil {
+		meta := m[0].(map[string]interface{})
+		if v, ok := meta["annotations"].(map[string]interface{}); ok && len(v) > 0 {
+			obj.VolumeClaimTemplate.Annotations = expandStringMap(v)
+		}
+		if v, ok := meta["labels"].(map[string]interface{}); ok && len(v) > 0 {
+			obj.VolumeClaimTemplate.Labels = expandStringMap(v)
+		}
+	}
+	return obj, nil
+}
+
 func expandReadinessGates(gates []interface{}) ([]v1.PodReadinessGate, error) {
 	if len(gates) == 0 || gates[0] == nil {
 		return []v1.PodReadinessGate{}, nil
@@ -1469,6 +1707,9 @@ func expandReadinessGates(gates []interface{}) ([]v1.PodReadinessGate, error) {
 	return cs, nil
 }
 
+// patchPodSpec patches the fields of a running pod that can be updated in place.
+// Changes to the other fields, e.g. runtime_class_name or seccomp_profile, force
+// a new pod, while workload resources replace their whole pod template.
 func patchPodSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOperations, error) {
 	ops := make([]PatchOperation, 0)
 
@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestFlattenTolerations(t *testing.T) {
@@ -558,3 +560,193 @@ func TestExpandThenFlatten_projected_volume(t *testing.T) {
 	}
 
 }
+
+func TestExpandThenFlatten_security_context_profiles(t *testing.T) {
+	in := &v1.PodSecurityContext{
+		SeccompProfile: &v1.SeccompProfile{
+			Type:             v1.SeccompProfileTypeLocalhost,
+			LocalhostProfile: ptrToString("profiles/audit.json"),
+		},
+		WindowsOptions: &v1.WindowsSecurityContextOptions{
+			GMSACredentialSpecName: ptrToString("gmsa-webapp"),
+			RunAsUserName:          ptrToString("ContainerUser"),
+		},
+	}
+	flattened := flattenPodSecurityContext(in)
+	out, err := expandPodSecurityContext(flattened)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(in, out) {
+		t.Fatal(cmp.Diff(in, out))
+	}
+
+	containerIn := &v1.SecurityContext{
+		SeccompProfile: &v1.SeccompProfile{
+			Type: v1.SeccompProfileTypeRuntimeDefault,
+		},
+		WindowsOptions: &v1.WindowsSecurityContextOptions{
+			GMSACredentialSpec:     ptrToString(`{"CmsPlugins": ["ActiveDirectory"]}`),
+			GMSACredentialSpecName: ptrToString("gmsa-webapp"),
+		},
+	}
+	containerOut, err := expandContainerSecurityContext(flattenContainerSecurityContext(containerIn))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(containerIn.SeccompProfile, containerOut.SeccompProfile) {
+		t.Fatal(cmp.Diff(containerIn.SeccompProfile, containerOut.SeccompProfile))
+	}
+	if !cmp.Equal(containerIn.WindowsOptions, containerOut.WindowsOptions) {
+		t.Fatal(cmp.Diff(containerIn.WindowsOptions, containerOut.WindowsOptions))
+	}
+}
+
+func TestExpandPodSpec_runtimeAndScheduling(t *testing.T) {
+	preemptNever := v1.PreemptNever
+	expected := v1.PodSpec{
+		RuntimeClassName:  ptrToString("gvisor"),
+		SchedulerName:     "batch-scheduler",
+		PreemptionPolicy:  &preemptNever,
+		SetHostnameAsFQDN: ptrToBool(true),
+		Overhead: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("250m"),
+			v1.ResourceMemory: resource.MustParse("120Mi"),
+		},
+	}
+
+	spec, err := expandPodSpec([]interface{}{map[string]interface{}{
+		"runtime_class_name":   "gvisor",
+		"scheduler_name":       "batch-scheduler",
+		"preemption_policy":    "Never",
+		"set_hostname_as_fqdn": true,
+		"overhead": map[string]interface{}{
+			"cpu":    "250m",
+			"memory": "120Mi",
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(expected.RuntimeClassName, spec.RuntimeClassName) ||
+		expected.SchedulerName != spec.SchedulerName ||
+		!cmp.Equal(expected.PreemptionPolicy, spec.PreemptionPolicy) ||
+		!cmp.Equal(expected.SetHostnameAsFQDN, spec.SetHostnameAsFQDN) {
+		t.Fatalf("Unexpected pod spec: %#v", spec)
+	}
+	for name, q := range expected.Overhead {
+		actual := spec.Overhead[name]
+		if q.Cmp(actual) != 0 {
+			t.Fatalf("Expected overhead %s of %s, got %s", name, q.String(), actual.String())
+		}
+	}
+
+	flattened, err := flattenPodSpec(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	att := flattened[0].(map[string]interface{})
+	if att["runtime_class_name"] != "gvisor" || att["scheduler_name"] != "batch-scheduler" ||
+		att["preemption_policy"] != "Never" || att["set_hostname_as_fqdn"] != true {
+		t.Fatalf("Unexpected flattened pod spec: %#v", att)
+	}
+	if overhead := att["overhead"].(map[string]string); overhead["cpu"] != "250m" || overhead["memory"] != "120Mi" {
+		t.Fatalf("Unexpected flattened overhead: %#v", overhead)
+	}
+}
+
+func TestExpandThenFlattenPodSpec_os(t *testing.T) {
+	in := []interface{}{map[string]interface{}{
+		"os": []interface{}{map[string]interface{}{
+			"name": "windows",
+		}},
+	}}
+
+	spec, err := expandPodSpec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.OS == nil || spec.OS.Name != v1.Windows {
+		t.Fatalf("Unexpected pod OS: %#v", spec.OS)
+	}
+
+	flattened, err := flattenPodSpec(*spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	att := flattened[0].(map[string]interface{})
+	if !cmp.Equal(att["os"], in[0].(map[string]interface{})["os"]) {
+		t.Fatal(cmp.Diff(in[0].(map[string]interface{})["os"], att["os"]))
+	}
+
+	spec, err = expandPodSpec([]interface{}{map[string]interface{}{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.OS != nil {
+		t.Fatalf("Expected no pod OS, got %#v", spec.OS)
+	}
+}
+
+func TestExpandThenFlatten_csi_and_ephemeral_volumes(t *testing.T) {
+	storageClass := "scratch"
+	in := []v1.Volume{
+		{
+			Name: "secrets-store",
+			VolumeSource: v1.VolumeSource{
+				CSI: &v1.CSIVolumeSource{
+					Driver:   "secrets-store.csi.k8s.io",
+					ReadOnly: ptrToBool(true),
+					VolumeAttributes: map[string]string{
+						"secretProviderClass": "vault-database",
+					},
+					NodePublishSecretRef: &v1.LocalObjectReference{Name: "secrets-store-creds"},
+				},
+			},
+		},
+		{
+			Name: "scratch",
+			VolumeSource: v1.VolumeSource{
+				Ephemeral: &v1.EphemeralVolumeSource{
+					VolumeClaimTemplate: &v1.PersistentVolumeClaimTemplate{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"type": "scratch"},
+						},
+						Spec: v1.PersistentVolumeClaimSpec{
+							AccessModes:      []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+							StorageClassName: &storageClass,
+							Resources: v1.ResourceRequirements{
+								Requests: v1.ResourceList{
+									v1.ResourceStorage: resource.MustParse("1Gi"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	flattened, err := flattenVolumes(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Set the volumes in the state, so that the maps are of the types read by the expanders
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"volume": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     volumeSchema(true),
+		},
+	}, map[string]interface{}{})
+	if err := d.Set("volume", flattened); err != nil {
+		t.Fatal(err)
+	}
+	out, err := expandVolumes(d.Get("volume").([]interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(in, out) {
+		t.Fatal(cmp.Diff(in, out))
+	}
+}
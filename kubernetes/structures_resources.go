@@ -0,0 +1,34 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// flattenResourcesObject flattens a listed object. Like in flattenMetadata,
+// the annotations and labels ignored by the provider are dropped from its
+// metadata, but the serialized object is returned as is.
+func flattenResourcesObject(obj *unstructured.Unstructured, ignoreAnnotations, ignoreLabels []*regexp.Regexp) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to serialize %s %q: %s", obj.GetKind(), obj.GetName(), err)
+	}
+	m := map[string]interface{}{
+		"name":             obj.GetName(),
+		"namespace":        obj.GetNamespace(),
+		"uid":              string(obj.GetUID()),
+		"resource_version": obj.GetResourceVersion(),
+		"generation":       int(obj.GetGeneration()),
+		"labels":           removeKeys(obj.GetLabels(), nil, ignoreLabels),
+		"annotations":      removeKeys(obj.GetAnnotations(), nil, ignoreAnnotations),
+	}
+	return map[string]interface{}{
+		"api_version": obj.GetAPIVersion(),
+		"kind":        obj.GetKind(),
+		"metadata":    []interface{}{m},
+		"object":      string(data),
+	}, nil
+}
@@ -0,0 +1,59 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFlattenResourcesObject(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Node",
+		"metadata": map[string]interface{}{
+			"name":            "node-1",
+			"uid":             "3f9b7a3e-2c1d-4d5e-9f6a-0b1c2d3e4f5a",
+			"resourceVersion": "42",
+			"labels":          map[string]interface{}{"kubernetes.io/os": "linux", "topology.kubernetes.io/zone": "eu-west-1a"},
+			"annotations":     map[string]interface{}{"node.alpha.kubernetes.io/ttl": "0"},
+		},
+		"status": map[string]interface{}{
+			"addresses": []interface{}{
+				map[string]interface{}{"type": "InternalIP", "address": "10.0.0.1"},
+			},
+		},
+	}}
+
+	ignoreAnnotations := []*regexp.Regexp{regexp.MustCompile(`^node\.alpha\.kubernetes\.io/`)}
+	ignoreLabels := []*regexp.Regexp{regexp.MustCompile(`^topology\.`)}
+	out, err := flattenResourcesObject(obj, ignoreAnnotations, ignoreLabels)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out["api_version"] != "v1" || out["kind"] != "Node" {
+		t.Fatalf("Unexpected type meta: %q %q", out["api_version"], out["kind"])
+	}
+	expectedMeta := map[string]interface{}{
+		"name":             "node-1",
+		"namespace":        "",
+		"uid":              "3f9b7a3e-2c1d-4d5e-9f6a-0b1c2d3e4f5a",
+		"resource_version": "42",
+		"generation":       0,
+		"labels":           map[string]string{"kubernetes.io/os": "linux"},
+		"annotations":      map[string]string{},
+	}
+	if m := out["metadata"].([]interface{})[0]; !reflect.DeepEqual(m, expectedMeta) {
+		t.Fatalf("Unexpected metadata.\nExpected: %#v\nGiven: %#v", expectedMeta, m)
+	}
+
+	decoded := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(out["object"].(string)), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, obj.Object) {
+		t.Fatalf("Object did not round-trip.\nExpected: %#v\nGiven: %#v", obj.Object, decoded)
+	}
+}
@@ -2,7 +2,11 @@ package kubernetes
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestIsInternalKey(t *testing.T) {
@@ -31,3 +35,66 @@ func TestIsInternalKey(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveKeys(t *testing.T) {
+	ignore := []*regexp.Regexp{
+		regexp.MustCompile(`^sidecar\.istio\.io/`),
+		regexp.MustCompile(`^argocd\.argoproj\.io/`),
+	}
+	in := map[string]string{
+		"sidecar.istio.io/status":               "injected",
+		"sidecar.istio.io/inject":               "true",
+		"argocd.argoproj.io/tracking-id":        "app:v1/ConfigMap:default/test",
+		"example.com/owner":                     "team-a",
+		"not.sidecar.istio.io/prefixed-the-key": "kept",
+	}
+	config := map[string]interface{}{
+		"sidecar.istio.io/inject": "true",
+		"example.com/owner":       "team-a",
+	}
+	expected := map[string]string{
+		"sidecar.istio.io/inject":               "true",
+		"example.com/owner":                     "team-a",
+		"not.sidecar.istio.io/prefixed-the-key": "kept",
+	}
+
+	out := removeKeys(in, config, ignore)
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Unexpected keys.\nExpected: %#v\nGiven: %#v", expected, out)
+	}
+}
+
+func TestFlattenResourceMetadata(t *testing.T) {
+	d := resourceKubernetesConfigMap().TestResourceData()
+	d.Set("metadata", []interface{}{map[string]interface{}{
+		"annotations": map[string]interface{}{"sidecar.istio.io/inject": "true"},
+	}})
+	meta := func() metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name: "test",
+			Annotations: map[string]string{
+				"sidecar.istio.io/inject": "true",
+				"sidecar.istio.io/status": "injected",
+			},
+			Labels: map[string]string{"argocd.argoproj.io/instance": "app"},
+		}
+	}
+	k := &kubeClientsets{
+		ignoreAnnotations: []*regexp.Regexp{regexp.MustCompile(`^sidecar\.istio\.io/`)},
+		ignoreLabels:      []*regexp.Regexp{regexp.MustCompile(`^argocd\.argoproj\.io/`)},
+	}
+
+	m := flattenResourceMetadata(meta(), d, k)[0].(map[string]interface{})
+	if expected := map[string]string{"sidecar.istio.io/inject": "true"}; !reflect.DeepEqual(m["annotations"], expected) {
+		t.Fatalf("Expected annotations %#v, got %#v", expected, m["annotations"])
+	}
+	if expected := map[string]string{}; !reflect.DeepEqual(m["labels"], expected) {
+		t.Fatalf("Expected labels %#v, got %#v", expected, m["labels"])
+	}
+
+	// Without the provider settings, e.g. in tests, no key is ignored
+	m = flattenResourceMetadata(meta(), d, nil)[0].(map[string]interface{})
+	if len(m["annotations"].(map[string]string)) != 2 || len(m["labels"].(map[string]string)) != 1 {
+		t.Fatalf("Expected all the keys to be kept, got %#v and %#v", m["annotations"], m["labels"])
+	}
+}
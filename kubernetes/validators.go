@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -290,3 +291,30 @@ func validateTypeStringNullableIntOrPercent(v interface{}, key string) (ws []str
 
 	return
 }
+
+func validateManifest(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if _, err := expandManifest(v); err != nil {
+		es = append(es, fmt.Errorf("%s %s", key, err))
+	}
+	return
+}
+
+func validateOpenAPIV3Schema(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if _, err := expandOpenAPIV3Schema(v); err != nil {
+		es = append(es, fmt.Errorf("%s %s", key, err))
+	}
+	return
+}
+
+func validateDuration(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		es = append(es, fmt.Errorf("%s must be a duration such as 500ms or 10s: %s", key, err))
+	} else if d < 0 {
+		es = append(es, fmt.Errorf("%s must not be negative", key))
+	}
+	return
+}
@@ -0,0 +1,439 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// resourceGroupKinds maps the resources managing a single Kubernetes object
+// onto the kind of that object, for the shared `wait` block.
+var resourceGroupKinds = map[string]apimachineryschema.GroupKind{
+	"kubernetes_api_service":                      {Group: "apiregistration.k8s.io", Kind: "APIService"},
+	"kubernetes_cluster_role":                     {Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"},
+	"kubernetes_cluster_role_binding":             {Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"},
+	"kubernetes_config_map":                       {Group: "", Kind: "ConfigMap"},
+	"kubernetes_cron_job":                         {Group: "batch", Kind: "CronJob"},
+	"kubernetes_custom_resource_definition":       {Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"},
+	"kubernetes_csi_driver":                       {Group: "storage.k8s.io", Kind: "CSIDriver"},
+	"kubernetes_daemonset":                        {Group: "apps", Kind: "DaemonSet"},
+	"kubernetes_default_service_account":          {Group: "", Kind: "ServiceAccount"},
+	"kubernetes_deployment":                       {Group: "apps", Kind: "Deployment"},
+	"kubernetes_endpoints":                        {Group: "", Kind: "Endpoints"},
+	"kubernetes_horizontal_pod_autoscaler":        {Group: "autoscaling", Kind: "HorizontalPodAutoscaler"},
+	"kubernetes_ingress":                          {Group: "networking.k8s.io", Kind: "Ingress"},
+	"kubernetes_ingress_class":                    {Group: "networking.k8s.io", Kind: "IngressClass"},
+	"kubernetes_job":                              {Group: "batch", Kind: "Job"},
+	"kubernetes_limit_range":                      {Group: "", Kind: "LimitRange"},
+	"kubernetes_namespace":                        {Group: "", Kind: "Namespace"},
+	"kubernetes_network_policy":                   {Group: "networking.k8s.io", Kind: "NetworkPolicy"},
+	"kubernetes_persistent_volume":                {Group: "", Kind: "PersistentVolume"},
+	"kubernetes_persistent_volume_claim":          {Group: "", Kind: "PersistentVolumeClaim"},
+	"kubernetes_pod":                              {Group: "", Kind: "Pod"},
+	"kubernetes_pod_disruption_budget":            {Group: "policy", Kind: "PodDisruptionBudget"},
+	"kubernetes_pod_security_policy":              {Group: "policy", Kind: "PodSecurityPolicy"},
+	"kubernetes_priority_class":                   {Group: "scheduling.k8s.io", Kind: "PriorityClass"},
+	"kubernetes_replication_controller":           {Group: "", Kind: "ReplicationController"},
+	"kubernetes_role_binding":                     {Group: "rbac.authorization.k8s.io", Kind: "RoleBinding"},
+	"kubernetes_resource_quota":                   {Group: "", Kind: "ResourceQuota"},
+	"kubernetes_role":                             {Group: "rbac.authorization.k8s.io", Kind: "Role"},
+	"kubernetes_secret":                           {Group: "", Kind: "Secret"},
+	"kubernetes_service":                          {Group: "", Kind: "Service"},
+	"kubernetes_service_account":                  {Group: "", Kind: "ServiceAccount"},
+	"kubernetes_stateful_set":                     {Group: "apps", Kind: "StatefulSet"},
+	"kubernetes_storage_class":                    {Group: "storage.k8s.io", Kind: "StorageClass"},
+	"kubernetes_validating_webhook_configuration": {Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"},
+	"kubernetes_mutating_webhook_configuration":   {Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"},
+}
+
+// waitTarget identifies the object managed by an instance of a resource.
+// An empty version selects the version preferred by the API server.
+type waitTarget func(d *schema.ResourceData) (apimachineryschema.GroupVersionKind, string, string, error)
+
+func metadataWaitTarget(gk apimachineryschema.GroupKind) waitTarget {
+	return func(d *schema.ResourceData) (apimachineryschema.GroupVersionKind, string, string, error) {
+		name, _ := d.Get("metadata.0.name").(string)
+		namespace, _ := d.Get("metadata.0.namespace").(string)
+		return gk.WithVersion(""), namespace, name, nil
+	}
+}
+
+func manifestWaitTarget(d *schema.ResourceData) (apimachineryschema.GroupVersionKind, string, string, error) {
+	return manifestIdParts(d.Id())
+}
+
+// addWaitToResource adds the `wait` block to the resource, and makes create
+// and update wait for the object to meet its criteria before returning.
+func addWaitToResource(r *schema.Resource, target waitTarget) {
+	r.Schema["wait"] = waitSchema()
+
+	create := r.CreateContext
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := create(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, waitForResource(ctx, r, d, meta, target, d.Timeout(schema.TimeoutCreate))...)
+	}
+
+	update := r.UpdateContext
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := update(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, waitForResource(ctx, r, d, meta, target, d.Timeout(schema.TimeoutUpdate))...)
+	}
+}
+
+func waitForResource(ctx context.Context, r *schema.Resource, d *schema.ResourceData, meta interface{}, target waitTarget, timeout time.Duration) diag.Diagnostics {
+	cfg := expandWaitCriteria(r, d)
+	if cfg == nil || d.Id() == "" {
+		return nil
+	}
+
+	gvk, namespace, name, err := target(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = waitForObject(ctx, meta, gvk, namespace, name, cfg, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Refresh the state with the status the object was waited for
+	return r.ReadContext(ctx, d, meta)
+}
+
+type waitCondition struct {
+	Type   string
+	Status string
+}
+
+type waitConfig struct {
+	Conditions   []waitCondition
+	Fields       map[string]string
+	Rollout      bool
+	Completion   bool
+	LoadBalancer bool
+}
+
+// expandWaitCriteria returns the criteria of the `wait` block, merged with those
+// of the older `wait_for_*` attributes of the resource, which are aliases of it.
+func expandWaitCriteria(r *schema.Resource, d *schema.ResourceData) *waitConfig {
+	cfg := expandWait(d.Get("wait").([]interface{}))
+	alias := func(name string) bool {
+		if _, ok := r.Schema[name]; !ok || !d.Get(name).(bool) {
+			return false
+		}
+		if cfg == nil {
+			cfg = &waitConfig{}
+		}
+		return true
+	}
+
+	if alias("wait_for_rollout") {
+		cfg.Rollout = true
+	}
+	// A suspended job doesn't run until it's resumed
+	if suspend, _ := d.Get("spec.0.suspend").(bool); !suspend && alias("wait_for_completion") {
+		cfg.Completion = true
+	}
+	if alias("wait_for_load_balancer") {
+		cfg.LoadBalancer = true
+	}
+	return cfg
+}
+
+func expandWait(l []interface{}) *waitConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	in := l[0].(map[string]interface{})
+	cfg := &waitConfig{}
+
+	if v, ok := in["condition"].([]interface{}); ok {
+		for _, c := range v {
+			m := c.(map[string]interface{})
+			cfg.Conditions = append(cfg.Conditions, waitCondition{
+				Type:   m["type"].(string),
+				Status: m["status"].(string),
+			})
+		}
+	}
+	if v, ok := in["fields"].(map[string]interface{}); ok && len(v) > 0 {
+		cfg.Fields = expandStringMap(v)
+	}
+	if v, ok := in["rollout"].(bool); ok {
+		cfg.Rollout = v
+	}
+
+	return cfg
+}
+
+// waitForObject polls the object until it meets all the criteria of the
+// wait configuration. On timeout, the last warning events of the object
+// are added to the error to hint at what is holding it up.
+func waitForObject(ctx context.Context, meta interface{}, gvk apimachineryschema.GroupVersionKind, namespace, name string, cfg *waitConfig, timeout time.Duration) error {
+	client, namespace, err := resourceKubernetesManifestClient(meta, gvk, namespace)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Waiting for %s %q to meet its wait criteria", gvk.Kind, name)
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		obj, err := client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			// The object may not be visible yet right after it was created
+			if errors.IsNotFound(err) {
+				return resource.RetryableError(fmt.Errorf("Waiting for %s %q to be found", gvk.Kind, name))
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		done, err := cfg.satisfiedBy(obj)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if !done.ok {
+			return resource.RetryableError(fmt.Errorf("Waiting for %s %q: %s", gvk.Kind, name, done.reason))
+		}
+		return nil
+	})
+	if err != nil {
+		conn, cErr := meta.(KubeClientsets).MainClientset()
+		if cErr != nil {
+			return err
+		}
+		md := metav1.ObjectMeta{Name: name, Namespace: namespace}
+		lastWarnings, wErr := getLastWarningsForObject(ctx, conn, md, gvk.Kind, 3)
+		if wErr != nil {
+			return err
+		}
+		return fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+	}
+
+	log.Printf("[INFO] %s %q met its wait criteria", gvk.Kind, name)
+	return nil
+}
+
+type waitResult struct {
+	ok     bool
+	reason string
+}
+
+func waitPending(format string, a ...interface{}) waitResult {
+	return waitResult{reason: fmt.Sprintf(format, a...)}
+}
+
+// satisfiedBy reports whether the object meets all the criteria. An error
+// means the object can no longer meet them, e.g. because a rollout failed.
+func (cfg *waitConfig) satisfiedBy(obj *unstructured.Unstructured) (waitResult, error) {
+	for _, c := range cfg.Conditions {
+		status, found := objectConditionStatus(obj, c.Type)
+		if !found {
+			return waitPending("condition %s is not reported yet", c.Type), nil
+		}
+		if status != c.Status {
+			return waitPending("condition %s is %s, expected %s", c.Type, status, c.Status), nil
+		}
+	}
+
+	keys := make([]string, 0, len(cfg.Fields))
+	for k := range cfg.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, path := range keys {
+		expected := cfg.Fields[path]
+		values, err := objectFieldValues(obj, path)
+		if err != nil {
+			return waitResult{}, err
+		}
+		if len(values) == 0 {
+			return waitPending("field %s is not set yet", path), nil
+		}
+		if expected != "" && values[0] != expected {
+			return waitPending("field %s is %q, expected %q", path, values[0], expected), nil
+		}
+	}
+
+	if cfg.Rollout {
+		done, err := objectRolloutStatus(obj)
+		if err != nil || !done.ok {
+			return done, err
+		}
+	}
+
+	if cfg.Completion {
+		done, err := objectCompletionStatus(obj)
+		if err != nil || !done.ok {
+			return done, err
+		}
+	}
+
+	if cfg.LoadBalancer {
+		return objectLoadBalancerStatus(obj), nil
+	}
+
+	return waitResult{ok: true}, nil
+}
+
+func objectConditionStatus(obj *unstructured.Unstructured, conditionType string) (string, bool) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if t, _ := m["type"].(string); t == conditionType {
+			status, _ := m["status"].(string)
+			return status, true
+		}
+	}
+	return "", false
+}
+
+// objectFieldValues evaluates a JSONPath expression against the object.
+// Both `status.phase` and the kubectl form `{.status.phase}` are accepted.
+func objectFieldValues(obj *unstructured.Unstructured, path string) ([]string, error) {
+	expr := path
+	if !strings.HasPrefix(expr, "{") {
+		expr = fmt.Sprintf("{.%s}", strings.TrimPrefix(expr, "."))
+	}
+
+	jp := jsonpath.New("wait")
+	jp.AllowMissingKeys(true)
+	err := jp.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid JSONPath expression %q in wait block: %s", path, err)
+	}
+
+	results, err := jp.FindResults(obj.Object)
+	if err != nil {
+		return nil, nil
+	}
+
+	var values []string
+	for _, r := range results {
+		for _, v := range r {
+			if !v.IsValid() || !v.CanInterface() || v.Interface() == nil {
+				continue
+			}
+			values = append(values, fmt.Sprintf("%v", v.Interface()))
+		}
+	}
+	return values, nil
+}
+
+// objectRolloutStatus follows the logic of `kubectl rollout status`.
+func objectRolloutStatus(obj *unstructured.Unstructured) (waitResult, error) {
+	observed, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if found && observed < obj.GetGeneration() {
+		return waitPending("waiting for the latest generation to be observed"), nil
+	}
+
+	replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if !found {
+		replicas = 1
+	}
+	status := func(field string) int64 {
+		v, _, _ := unstructured.NestedInt64(obj.Object, "status", field)
+		return v
+	}
+
+	switch obj.GetKind() {
+	case "Deployment":
+		conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+		for _, c := range conditions {
+			m, ok := c.(map[string]interface{})
+			if ok && m["type"] == "Progressing" && m["reason"] == TimedOutReason {
+				return waitResult{}, fmt.Errorf("Deployment %q exceeded its progress deadline", obj.GetName())
+			}
+		}
+		if status("updatedReplicas") < replicas {
+			return waitPending("%d out of %d new replicas have been updated", status("updatedReplicas"), replicas), nil
+		}
+		if status("replicas") > status("updatedReplicas") {
+			return waitPending("%d old replicas are pending termination", status("replicas")-status("updatedReplicas")), nil
+		}
+		if status("replicas") > status("readyReplicas") {
+			return waitPending("%d replicas wanted, %d replicas ready", status("replicas"), status("readyReplicas")), nil
+		}
+		if status("availableReplicas") < status("updatedReplicas") {
+			return waitPending("%d of %d updated replicas are available", status("availableReplicas"), status("updatedReplicas")), nil
+		}
+	case "StatefulSet":
+		if status("readyReplicas") < replicas {
+			return waitPending("%d of %d replicas are ready", status("readyReplicas"), replicas), nil
+		}
+		strategy, _, _ := unstructured.NestedString(obj.Object, "spec", "updateStrategy", "type")
+		partition, _, _ := unstructured.NestedInt64(obj.Object, "spec", "updateStrategy", "rollingUpdate", "partition")
+		if strategy != "OnDelete" && partition == 0 {
+			current, _, _ := unstructured.NestedString(obj.Object, "status", "currentRevision")
+			update, _, _ := unstructured.NestedString(obj.Object, "status", "updateRevision")
+			if current != update {
+				return waitPending("%d of %d replicas run the updated revision", status("updatedReplicas"), replicas), nil
+			}
+		}
+	case "DaemonSet":
+		desired := status("desiredNumberScheduled")
+		if status("updatedNumberScheduled") < desired {
+			return waitPending("%d out of %d new pods have been updated", status("updatedNumberScheduled"), desired), nil
+		}
+		if status("numberAvailable") < desired {
+			return waitPending("%d of %d updated pods are available", status("numberAvailable"), desired), nil
+		}
+	}
+
+	return waitResult{ok: true}, nil
+}
+
+// objectCompletionStatus waits for a job to complete. A failed job can no longer complete.
+func objectCompletionStatus(obj *unstructured.Unstructured) (waitResult, error) {
+	if obj.GetKind() != "Job" {
+		return waitResult{ok: true}, nil
+	}
+	job := &batchv1.Job{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, job); err != nil {
+		return waitResult{}, err
+	}
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobComplete && c.Status == corev1.ConditionTrue {
+			return waitResult{ok: true}, nil
+		}
+	}
+	if failure := describeJobFailure(job); failure != "" {
+		return waitResult{}, fmt.Errorf("job: %s/%s is in failed state: %s", job.Namespace, job.Name, failure)
+	}
+	return waitPending("the job is not in complete state: %s", describeJobProgress(job.Status)), nil
+}
+
+// objectLoadBalancerStatus waits for the load balancer of a service or an ingress to have
+// at least one ingress point. Services which are not of type LoadBalancer have none.
+func objectLoadBalancerStatus(obj *unstructured.Unstructured) waitResult {
+	if t, _, _ := unstructured.NestedString(obj.Object, "spec", "type"); obj.GetKind() == "Service" && t != string(corev1.ServiceTypeLoadBalancer) {
+		return waitResult{ok: true}
+	}
+	ingress, _, _ := unstructured.NestedSlice(obj.Object, "status", "loadBalancer", "ingress")
+	if len(ingress) == 0 {
+		return waitPending("the load balancer has no IP or hostname yet")
+	}
+	return waitResult{ok: true}
+}
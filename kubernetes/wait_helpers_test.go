@@ -0,0 +1,179 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestWaitConfigSatisfiedBy(t *testing.T) {
+	pvc := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "PersistentVolumeClaim",
+		"metadata":   map[string]interface{}{"name": "data", "generation": int64(1)},
+		"status": map[string]interface{}{
+			"phase": "Bound",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Resizing", "status": "False"},
+			},
+			"capacity": map[string]interface{}{"storage": "1Gi"},
+		},
+	}}
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "generation": int64(2)},
+		"spec":       map[string]interface{}{"replicas": int64(3)},
+		"status": map[string]interface{}{
+			"observedGeneration": int64(2),
+			"replicas":           int64(4),
+			"updatedReplicas":    int64(3),
+			"readyReplicas":      int64(3),
+			"availableReplicas":  int64(3),
+		},
+	}}
+	job := func(conditionType string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "batch/v1",
+			"kind":       "Job",
+			"metadata":   map[string]interface{}{"name": "migrate", "namespace": "default"},
+			"status": map[string]interface{}{
+				"active": int64(1),
+				"conditions": []interface{}{
+					map[string]interface{}{"type": conditionType, "status": "True", "reason": "BackoffLimitExceeded"},
+				},
+			},
+		}}
+	}
+	service := func(serviceType string, ingress ...interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]interface{}{"name": "web"},
+			"spec":       map[string]interface{}{"type": serviceType},
+			"status": map[string]interface{}{
+				"loadBalancer": map[string]interface{}{"ingress": ingress},
+			},
+		}}
+	}
+
+	testCases := []struct {
+		Name      string
+		Config    *waitConfig
+		Object    *unstructured.Unstructured
+		Done      bool
+		ExpectErr bool
+	}{
+		{"condition met", &waitConfig{Conditions: []waitCondition{{Type: "Resizing", Status: "False"}}}, pvc, true, false},
+		{"condition not met", &waitConfig{Conditions: []waitCondition{{Type: "Resizing", Status: "True"}}}, pvc, false, false},
+		{"condition missing", &waitConfig{Conditions: []waitCondition{{Type: "Ready", Status: "True"}}}, pvc, false, false},
+		{"field equals", &waitConfig{Fields: map[string]string{"status.phase": "Bound"}}, pvc, true, false},
+		{"field differs", &waitConfig{Fields: map[string]string{"status.phase": "Pending"}}, pvc, false, false},
+		{"field set", &waitConfig{Fields: map[string]string{"{.status.capacity.storage}": ""}}, pvc, true, false},
+		{"field missing", &waitConfig{Fields: map[string]string{"status.loadBalancer.ingress[0].ip": ""}}, pvc, false, false},
+		{"invalid path", &waitConfig{Fields: map[string]string{"status.[": ""}}, pvc, false, true},
+		{"rollout with old replicas", &waitConfig{Rollout: true}, deployment, false, false},
+		{"job complete", &waitConfig{Completion: true}, job("Complete"), true, false},
+		{"job running", &waitConfig{Completion: true}, job("Suspended"), false, false},
+		{"job failed", &waitConfig{Completion: true}, job("Failed"), false, true},
+		{"load balancer pending", &waitConfig{LoadBalancer: true}, service("LoadBalancer"), false, false},
+		{"load balancer ready", &waitConfig{LoadBalancer: true}, service("LoadBalancer", map[string]interface{}{"ip": "10.0.0.1"}), true, false},
+		{"not a load balancer", &waitConfig{LoadBalancer: true}, service("ClusterIP"), true, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			result, err := tc.Config.satisfiedBy(tc.Object)
+			if tc.ExpectErr {
+				if err == nil {
+					t.Fatal("Expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.ok != tc.Done {
+				t.Fatalf("Expected done to be %t, got %t (%s)", tc.Done, result.ok, result.reason)
+			}
+		})
+	}
+
+	unstructured.SetNestedField(deployment.Object, int64(3), "status", "replicas")
+	result, err := (&waitConfig{Rollout: true}).satisfiedBy(deployment)
+	if err != nil || !result.ok {
+		t.Fatalf("Expected rollout to be complete, got %#v, %v", result, err)
+	}
+
+	unstructured.SetNestedSlice(deployment.Object, []interface{}{
+		map[string]interface{}{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded"},
+	}, "status", "conditions")
+	_, err = (&waitConfig{Rollout: true}).satisfiedBy(deployment)
+	if err == nil {
+		t.Fatal("Expected an error for a deployment past its progress deadline")
+	}
+}
+
+func TestExpandWaitCriteria(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Resource *schema.Resource
+		Config   map[string]interface{}
+		Expected *waitConfig
+	}{
+		{
+			"deployment default",
+			resourceKubernetesDeployment(),
+			map[string]interface{}{},
+			&waitConfig{Rollout: true},
+		},
+		{
+			"deployment without rollout",
+			resourceKubernetesDeployment(),
+			map[string]interface{}{"wait_for_rollout": false},
+			nil,
+		},
+		{
+			"deployment with wait block",
+			resourceKubernetesDeployment(),
+			map[string]interface{}{"wait": []interface{}{map[string]interface{}{"fields": map[string]interface{}{"status.readyReplicas": "3"}}}},
+			&waitConfig{Fields: map[string]string{"status.readyReplicas": "3"}, Rollout: true},
+		},
+		{
+			"job",
+			resourceKubernetesJob(),
+			map[string]interface{}{"wait_for_completion": true},
+			&waitConfig{Completion: true},
+		},
+		{
+			"suspended job",
+			resourceKubernetesJob(),
+			map[string]interface{}{"wait_for_completion": true, "spec": []interface{}{map[string]interface{}{"suspend": true}}},
+			nil,
+		},
+		{
+			"service",
+			resourceKubernetesService(),
+			map[string]interface{}{},
+			&waitConfig{LoadBalancer: true},
+		},
+		{
+			"config map",
+			resourceKubernetesConfigMap(),
+			map[string]interface{}{},
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			r := tc.Resource
+			r.Schema["wait"] = waitSchema()
+			d := schema.TestResourceDataRaw(t, r.Schema, tc.Config)
+			cfg := expandWaitCriteria(r, d)
+			if !reflect.DeepEqual(cfg, tc.Expected) {
+				t.Fatalf("Expected %#v, got %#v", tc.Expected, cfg)
+			}
+		})
+	}
+}
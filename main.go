@@ -13,7 +13,7 @@ func main() {
 	flag.Parse()
 
 	serveOpts := &plugin.ServeOpts{
-		ProviderFunc: kubernetes.Provider,
+		GRPCProviderFunc: kubernetes.NewProviderServer,
 	}
 	if debugFlag != nil && *debugFlag {
 		plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/kubernetes", serveOpts)